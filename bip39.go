@@ -2,7 +2,7 @@ package bip39
 
 import (
 	"crypto/sha512"
-	"slices"
+	"strings"
 
 	"golang.org/x/crypto/pbkdf2"
 )
@@ -11,79 +11,97 @@ import (
 // No checking is performed to validate that the string provided is a valid mnemonic.
 // default passphrase is empty string.
 // if you want to set passphrase, use WithPassphrase() option.
+// Both the mnemonic and the passphrase are normalized to NFKD form as required by BIP39.
 func NewSeed(mnemonic string, opts ...NewSeedOption) []byte {
 	options := &NewSeedOptions{}
 	for _, opt := range opts {
 		opt(options)
 	}
-	return pbkdf2.Key([]byte(mnemonic), []byte("mnemonic"+options.passphrase), 2048, 64, sha512.New)
+	return pbkdf2.Key([]byte(normalizeString(mnemonic)), []byte(normalizeString("mnemonic"+options.passphrase)), 2048, 64, sha512.New)
 }
 
-// IsMnemonicValid attempts to verify that the provided mnemonic is valid.
-// Validity is determined by both the number of words being appropriate,
-// and that all the words in the mnemonic are present in the word list.
+// IsMnemonicValid reports whether the mnemonic is valid in at least one of the languages detected by DetectLanguage:
+// its number of words is valid, all its words are words of the language, and its checksum is valid.
+//
+// A mnemonic detected in several languages, such as English and French which share 100 words,
+// is valid if its checksum is valid in one of them. Use Validate to know which.
+// It does not allocate for a mnemonic in NFKD form, as generated.
 func IsMnemonicValid(mnemonic string) bool {
-	languages, ok := DetectLanguage(mnemonic)
-	if !ok {
+	// A Japanese space around the words is trimmed as by SplitMnemonic, it does not make a mnemonic Japanese.
+	mnemonic = strings.TrimSpace(mnemonic)
+	var words [maxWordsCount]string
+	n := splitWords(&words, mnemonic)
+	if !isValidWordsSize(n) {
 		return false
 	}
-	for _, lang := range languages {
-		m, err := NewMnemonic(WithLanguage(lang))
-		if err != nil {
-			return false
-		}
-		_, err = m.EntropyFromMnemonic(mnemonic)
-		if err != nil {
-			return false
+	possible := allLanguages
+	if strings.ContainsRune(mnemonic, japaneseSpace) {
+		possible = 1 << Japanese
+	}
+	reg := registry()
+	normalizeUnknownWords(words[:n], reg.words)
+	var buf [maxEntropyWithChecksumSize]byte
+	for l, data := range reg.data {
+		if possible.has(Language(l)) && checkWords(data.wordsMap, words[:n], buf[:]) == nil {
+			return true
 		}
 	}
-	return true
+	return false
 }
 
 // DetectLanguage detect and return the languages of the mnemonic.
 // Default all languages are possible.
 // In some cases, multiple languages might be matched simultaneously, such as Simplified Chinese and Traditional Chinese.
 // If you only want to perform detection within a specified list of languages. use WithLanguages() option.
+// If you want to detect the language of abbreviated words, use WithPrefixDetection() option.
+// If you want to drop the languages in which the checksum fails, use WithChecksumDetection() option,
+// and see RankLanguages to rank them.
 func DetectLanguage(mnemonic string, opts ...DetectLanguageOption) (languages []Language, ok bool) {
 	options := &DetectLanguageOptions{}
 	for _, opt := range opts {
 		opt(options)
 	}
-	possible := innerLanguages()
+	possible := detectLanguages(mnemonic, options)
+	return possible.list(), possible != 0
+}
+
+// detectLanguages returns the set of the languages of the mnemonic, see DetectLanguage.
+// It takes one lookup per word in the reverse index of the registry, and allocates nothing for NFKD input.
+func detectLanguages(mnemonic string, options *DetectLanguageOptions) languageSet {
+	possible := allLanguages
 	if len(options.languages) > 0 {
 		// If languages are specified, then only those languages are possible.
-		for lang := range possible {
-			if !slices.Contains(options.languages, lang) {
-				delete(possible, lang)
-			}
-		}
+		possible = newLanguageSet(options.languages)
 	}
-	words, delimiter := SplitMnemonic(mnemonic)
-	if delimiter == string(japaneseSpace) {
+	mnemonic = strings.TrimSpace(mnemonic)
+	if strings.ContainsRune(mnemonic, japaneseSpace) {
 		// If the delimiter is a Japanese space, then the language must be Japanese.
-		for lang := range possible {
-			if lang != Japanese {
-				delete(possible, lang)
-			}
-		}
+		possible &= 1 << Japanese
 	}
-	for _, word := range words {
-		for lang, data := range possible {
-			if _, ok := data.wordsMap[word]; !ok {
-				delete(possible, lang)
+	reg := registry()
+	for word := range strings.FieldsFuncSeq(mnemonic, isWordDelimiter) {
+		if options.prefixes {
+			word = normalizeString(word)
+			for l := range Language(languageCount) {
+				if data := reg.data[l]; possible.has(l) && !hasPrefix(data.wordsMap, data.index, word) {
+					possible &^= 1 << l
+				}
 			}
-		}
-		if len(possible) == 1 {
-			for lang := range possible {
-				ok = true
-				languages = append(languages, lang)
-				return
+		} else {
+			// The words are indexed in NFKD form, so other forms are normalized only when they are not found.
+			set, ok := reg.words[word]
+			if !ok {
+				set = reg.words[normalizeString(word)]
 			}
+			possible &= set
+		}
+		// A single possible language is the language of the mnemonic, the other words are not checked.
+		if _, ok := possible.single(); ok {
+			break
 		}
 	}
-	ok = len(possible) > 0
-	for lang := range possible {
-		languages = append(languages, lang)
+	if options.checksum {
+		possible = dropInvalidChecksums(mnemonic, possible, options.prefixes)
 	}
-	return
+	return possible
 }
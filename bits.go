@@ -0,0 +1,68 @@
+package bip39
+
+import "crypto/sha256"
+
+const (
+	// bitsPerWord is the number of bits encoded by each word of the 2048 words lists.
+	bitsPerWord = 11
+	// maxWordsCount is the number of words of the longest mnemonics.
+	maxWordsCount = 24
+	// maxEntropyWithChecksumSize is the number of bytes holding the longest entropy followed by its checksum.
+	maxEntropyWithChecksumSize = 33
+)
+
+// The word indexes of a mnemonic are the entropy followed by the checksum, cut into 11 bits numbers.
+// They are packed into and extracted from fixed size buffers, usually on the stack, without math/big.
+
+// checksumOf returns the checksum of entropy, the first len(entropy)/4 bits of its SHA256 hash,
+// in the least significant bits.
+func checksumOf(entropy []byte) byte {
+	hash := sha256.Sum256(entropy)
+	return hash[0] >> (8 - len(entropy)/4)
+}
+
+// isChecksumValid reports whether the checksum of the word indexes of a mnemonic is valid.
+// buf must be at least 33 bytes, the entropy followed by the checksum is written to it.
+func isChecksumValid(indexes []int, buf []byte) bool {
+	packIndexes(indexes, buf)
+	checksumBits := len(indexes) / 3
+	entropySize := checksumBits * 4
+	return buf[entropySize]>>(8-checksumBits) == checksumOf(buf[:entropySize])
+}
+
+// packIndexes writes the 11 bits of each word index to buf, most significant first, and clears the rest of buf.
+// buf must be large enough to hold all the bits.
+func packIndexes(indexes []int, buf []byte) {
+	// acc holds the bits not written yet in its lowest pending bits, at most 7 plus the 11 of an index.
+	var acc uint32
+	pending, n := 0, 0
+	for _, index := range indexes {
+		acc = acc<<bitsPerWord | uint32(index)&(1<<bitsPerWord-1)
+		pending += bitsPerWord
+		for pending >= 8 {
+			pending -= 8
+			buf[n] = byte(acc >> pending)
+			n++
+		}
+	}
+	if pending > 0 {
+		buf[n] = byte(acc << (8 - pending))
+		n++
+	}
+	clear(buf[n:])
+}
+
+// extractBits returns length bits of data starting at bit start, most significant first, as packIndexes writes them.
+// length must be at most 25, and the bits past the end of data are zeros.
+func extractBits(data []byte, start, length int) int {
+	// window holds the 4 bytes covering the bits, whatever their alignment.
+	var window uint32
+	first := start / 8
+	for i := range 4 {
+		window <<= 8
+		if first+i < len(data) {
+			window |= uint32(data[first+i])
+		}
+	}
+	return int(window >> (32 - start%8 - length) & (1<<length - 1))
+}
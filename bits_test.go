@@ -0,0 +1,229 @@
+package bip39
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+// bigEntropyFromWords is the former math/big implementation of entropyFromWords,
+// kept to check the bit packing against it and to benchmark the difference.
+func bigEntropyFromWords(m *Mnemonic, words []string) ([]byte, error) {
+	wordsCount := len(words)
+	if !isValidWordsSize(wordsCount) {
+		return nil, ErrInvalidNumberWords
+	}
+	b := big.NewInt(0)
+	for _, word := range words {
+		index, ok := m.wordMap[word]
+		if !ok {
+			return nil, ErrInvalidMnemonic
+		}
+		var wordBytes [2]byte
+		binary.BigEndian.PutUint16(wordBytes[:], uint16(index))
+		b = b.Mul(b, big.NewInt(2048))
+		b = b.Or(b, big.NewInt(0).SetBytes(wordBytes[:]))
+	}
+	checksumBits := wordsCount / 3
+	checksumMask := big.NewInt(1<<checksumBits - 1)
+	checksum := big.NewInt(0).And(b, checksumMask)
+	b.Div(b, big.NewInt(0).Add(checksumMask, big.NewInt(1)))
+	entropy := b.Bytes()
+	if offset := checksumBits*4 - len(entropy); offset > 0 {
+		entropy = append(make([]byte, offset), entropy...)
+	}
+	hash := sha256.Sum256(entropy)
+	if checksum.Cmp(big.NewInt(int64(hash[0]>>(8-checksumBits)))) != 0 {
+		return nil, ErrChecksumIncorrect
+	}
+	return entropy, nil
+}
+
+func TestBitPacking(t *testing.T) {
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	random := NewDeterministicRandom([]byte("bits"))
+	for _, size := range []int{16, 20, 24, 28, 32} {
+		for range 200 {
+			entropy := make([]byte, size)
+			if _, err := random.Read(entropy); err != nil {
+				t.Fatal(err)
+			}
+			mnemonic, err := m.EntropyToMnemonic(entropy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			words, _ := SplitMnemonic(mnemonic)
+			expected, err := bigEntropyFromWords(m, words)
+			if err != nil || !bytes.Equal(expected, entropy) {
+				t.Fatalf("%x: invalid mnemonic %s", entropy, mnemonic)
+			}
+			actual, err := m.EntropyFromMnemonic(mnemonic)
+			if err != nil || !bytes.Equal(actual, entropy) {
+				t.Fatalf("%x: invalid entropy %x %v", entropy, actual, err)
+			}
+			// Changing the last word breaks the checksum in both implementations alike.
+			words[len(words)-1] = m.wordList[(m.wordMap[words[len(words)-1]]+1)%len(m.wordList)]
+			_, expectedErr := bigEntropyFromWords(m, words)
+			_, actualErr := m.entropyFromWords(words)
+			if !errors.Is(actualErr, expectedErr) {
+				t.Fatalf("%s: invalid error %v, expected %v", strings.Join(words, " "), actualErr, expectedErr)
+			}
+		}
+	}
+}
+
+func TestEntropyToMnemonicKeepsEntropy(t *testing.T) {
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The checksum used to be appended to the entropy, overwriting the byte after it when there was room.
+	buf := make([]byte, 17)
+	buf[16] = 0x42
+	if _, err := m.EntropyToMnemonic(buf[:16]); err != nil {
+		t.Fatal(err)
+	}
+	if buf[16] != 0x42 {
+		t.Fatalf("entropy overwritten %x", buf)
+	}
+}
+
+func TestCheckWordsAllocations(t *testing.T) {
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	words, _ := SplitMnemonic("void come effort suffer camp survey warrior heavy shoot primary clutch crush open amazing screen patrol group space point ten exist slush involve unfold")
+	var buf [maxEntropyWithChecksumSize]byte
+	allocs := testing.AllocsPerRun(100, func() {
+		if err := checkWords(m.wordMap, words, buf[:]); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Fatalf("%v allocations", allocs)
+	}
+}
+
+func TestMnemonicValidationAllocations(t *testing.T) {
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ja, err := NewMnemonic(WithLanguage(Japanese))
+	if err != nil {
+		t.Fatal(err)
+	}
+	japanese, err := ja.GenerateMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, mnemonic := range []string{
+		"void come effort suffer camp survey warrior heavy shoot primary clutch crush open amazing screen patrol group space point ten exist slush involve unfold",
+		// Valid in English only, the checksum is checked in French too.
+		"abandon amateur angle animal aspect badge bicycle bonus brave canal capable abandon",
+		japanese,
+	} {
+		if allocs := testing.AllocsPerRun(100, func() {
+			if !IsMnemonicValid(mnemonic) {
+				t.Fatal("invalid mnemonic", mnemonic)
+			}
+		}); allocs != 0 {
+			t.Fatalf("IsMnemonicValid: %v allocations", allocs)
+		}
+	}
+	for _, mnemonic := range []string{"", "abandon", strings.Repeat("abandon ", 25), "abandon amateur angle animal aspect badge bicycle bonus brave canal capable amateur"} {
+		if allocs := testing.AllocsPerRun(100, func() {
+			if IsMnemonicValid(mnemonic) {
+				t.Fatal("valid mnemonic", mnemonic)
+			}
+		}); allocs != 0 {
+			t.Fatalf("IsMnemonicValid: %v allocations", allocs)
+		}
+	}
+	// The entropy is the only allocation.
+	mnemonic := "void come effort suffer camp survey warrior heavy shoot primary clutch crush open amazing screen patrol group space point ten exist slush involve unfold"
+	if allocs := testing.AllocsPerRun(100, func() {
+		if _, err := m.EntropyFromMnemonic(mnemonic); err != nil {
+			t.Fatal(err)
+		}
+	}); allocs != 1 {
+		t.Fatalf("EntropyFromMnemonic: %v allocations", allocs)
+	}
+}
+
+func benchmarkMnemonics(b *testing.B, m *Mnemonic) map[int]string {
+	mnemonics := make(map[int]string)
+	random := NewDeterministicRandom([]byte("benchmark"))
+	for _, bits := range validEntropyBits {
+		mnemonic, err := m.GenerateMnemonic(WithEntropyBits(bits), WithRandom(random))
+		if err != nil {
+			b.Fatal(err)
+		}
+		mnemonics[bits*3/32] = mnemonic
+	}
+	return mnemonics
+}
+
+// BenchmarkEntropyFromWords compares the bit packing, "packed", with the former math/big implementation, "big".
+func BenchmarkEntropyFromWords(b *testing.B) {
+	m, err := NewMnemonic()
+	if err != nil {
+		b.Fatal(err)
+	}
+	mnemonics := benchmarkMnemonics(b, m)
+	for _, wordsCount := range validWordsSizes {
+		words, _ := SplitMnemonic(mnemonics[wordsCount])
+		b.Run(fmt.Sprintf("words=%d/big", wordsCount), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := bigEntropyFromWords(m, words); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("words=%d/packed", wordsCount), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := m.entropyFromWords(words); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("words=%d/validate", wordsCount), func(b *testing.B) {
+			b.ReportAllocs()
+			var buf [maxEntropyWithChecksumSize]byte
+			for b.Loop() {
+				if err := checkWords(m.wordMap, words, buf[:]); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkEntropyToMnemonic(b *testing.B) {
+	m, err := NewMnemonic()
+	if err != nil {
+		b.Fatal(err)
+	}
+	for _, bits := range validEntropyBits {
+		entropy := bytes.Repeat([]byte{0x9e}, bits/8)
+		b.Run(fmt.Sprintf("words=%d", bits*3/32), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := m.EntropyToMnemonic(entropy); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package bip39
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"runtime"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+var ErrMemoryLock = errors.New("memory lock failed")
+
+// SecretBuffer holds a secret in a byte slice that can be wiped, such as a seed, entropy or a mnemonic.
+// It prints "[REDACTED]" and refuses to be marshaled, as SecretMnemonic does.
+//
+// Wiping memory in Go is a best effort. Wipe and Destroy overwrite the buffer, and the functions building it
+// wipe their intermediate copies, but they cannot reach:
+//   - strings, which are immutable: a mnemonic or a passphrase that was ever a string stays in memory until
+//     it is garbage collected and the memory reused, so read them into byte slices and keep them there;
+//   - copies made by the runtime and the compiler, such as stacks moved when they grow, or values spilled from
+//     registers, and the internal state of SHA256, HMAC and PBKDF2;
+//   - copies made by the caller, such as with append or by converting Bytes to a string.
+//
+// Without WithMemoryLock() option, the memory may also be written to swap or to a core dump.
+type SecretBuffer struct {
+	secret
+	// locked reports whether the value was allocated by lockedAlloc, to be freed by Destroy.
+	locked bool
+	// cleanup frees the locked memory if the buffer is garbage collected without Destroy.
+	cleanup runtime.Cleanup
+}
+
+// newSecretBuffer allocates a buffer of size bytes.
+func newSecretBuffer(size int, opts []SecretBufferOption) (*SecretBuffer, error) {
+	options := &SecretBufferOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	b := &SecretBuffer{}
+	b.name = "SecretBuffer"
+	// A buffer is never marshaled, but if it were, its raw bytes would be in hex.
+	b.binary = true
+	if !options.lock {
+		value := make([]byte, size)
+		b.value = func() []byte { return value }
+		return b, nil
+	}
+	locked, err := lockedAlloc(size)
+	if err != nil {
+		return nil, err
+	}
+	b.value, b.locked = func() []byte { return locked }, true
+	// The memory is not managed by the garbage collector, it is wiped and freed if Destroy is never called.
+	b.cleanup = runtime.AddCleanup(b, func(locked []byte) {
+		clear(locked)
+		_ = lockedFree(locked)
+	}, locked)
+	return b, nil
+}
+
+// Bytes returns the secret. It is the buffer itself, not a copy, so that no copy is left behind:
+// it is wiped by Wipe and becomes invalid after Destroy.
+func (b *SecretBuffer) Bytes() []byte {
+	return b.bytes()
+}
+
+// Len returns the number of bytes of the secret.
+func (b *SecretBuffer) Len() int {
+	return len(b.bytes())
+}
+
+// Locked reports whether the memory of the buffer is locked into RAM, see WithMemoryLock.
+func (b *SecretBuffer) Locked() bool {
+	return b.locked
+}
+
+// Wipe overwrites the secret with zeros. The buffer keeps its length.
+func (b *SecretBuffer) Wipe() {
+	clear(b.bytes())
+}
+
+// Destroy wipes the secret and releases the buffer, unlocking its memory. The buffer is empty afterwards.
+// It is safe to call several times. A locked buffer that is garbage collected without Destroy
+// is wiped and released then, but Destroy releases it as soon as it is no longer needed.
+func (b *SecretBuffer) Destroy() error {
+	b.Wipe()
+	value := b.bytes()
+	b.value = nil
+	if !b.locked {
+		return nil
+	}
+	b.cleanup.Stop()
+	b.locked = false
+	return lockedFree(value)
+}
+
+// NewSeedBuffer creates the seed of a mnemonic and a passphrase, as NewSeed does, from byte slices.
+// They are normalized to NFKD form without converting them to strings, and the intermediate copies are wiped.
+// Use WithMemoryLock() option to lock the seed into RAM. See SecretBuffer for the limits of wiping memory.
+func NewSeedBuffer(mnemonic, passphrase []byte, opts ...SecretBufferOption) (*SecretBuffer, error) {
+	password := appendNFKD(make([]byte, 0, len(mnemonic)), mnemonic)
+	defer clear(password)
+	salt := appendNFKD(append(make([]byte, 0, len("mnemonic")+len(passphrase)), "mnemonic"...), passphrase)
+	defer clear(salt)
+	key := pbkdf2.Key(password, salt, 2048, 64, sha512.New)
+	defer clear(key)
+
+	b, err := newSecretBuffer(len(key), opts)
+	if err != nil {
+		return nil, err
+	}
+	copy(b.bytes(), key)
+	return b, nil
+}
+
+// EntropyFromMnemonicBuffer converts a mnemonic to entropy, as EntropyFromMnemonic does, from a byte slice.
+// The words are looked up without converting them to strings, and the intermediate copies are wiped.
+// The words must be whole words, WithPrefixes() option is ignored. See SecretBuffer for the limits of wiping memory.
+// The error is a *MnemonicError as EntropyFromMnemonic returns, whose unknown words are strings that cannot be wiped.
+func (m *Mnemonic) EntropyFromMnemonicBuffer(mnemonic []byte, opts ...SecretBufferOption) (*SecretBuffer, error) {
+	// The Japanese space decomposes to a regular space, which separates the words all the same.
+	normalized := appendNFKD(make([]byte, 0, len(mnemonic)), mnemonic)
+	defer clear(normalized)
+	words := bytes.FieldsFunc(normalized, func(r rune) bool {
+		_, ok := delimiters[r]
+		return ok
+	})
+	if !isValidWordsSize(len(words)) {
+		return nil, m.mnemonicError(ErrInvalidNumberWords, len(words))
+	}
+	indexes := make([]int, len(words))
+	defer clear(indexes)
+	var mnemonicErr *MnemonicError
+	for i, word := range words {
+		// The conversion of a map key does not allocate a string.
+		index, ok := m.wordMap[string(word)]
+		if !ok {
+			if mnemonicErr == nil {
+				mnemonicErr = m.mnemonicError(ErrInvalidMnemonic, len(words))
+			}
+			mnemonicErr.Words = append(mnemonicErr.Words, WordError{Position: i, Word: string(word)})
+			continue
+		}
+		indexes[i] = index
+	}
+	if mnemonicErr != nil {
+		return nil, mnemonicErr
+	}
+	var buf [maxEntropyWithChecksumSize]byte
+	defer clear(buf[:])
+	if !isChecksumValid(indexes, buf[:]) {
+		return nil, m.mnemonicError(ErrChecksumIncorrect, len(words))
+	}
+
+	b, err := newSecretBuffer(len(words)/3*4, opts)
+	if err != nil {
+		return nil, err
+	}
+	copy(b.bytes(), buf[:])
+	return b, nil
+}
+
+// EntropyToMnemonicBuffer converts entropy to a mnemonic, as EntropyToMnemonic does, into a byte slice.
+// The words are written to the buffer directly, without building strings, and the intermediate copies are wiped.
+// See SecretBuffer for the limits of wiping memory.
+func (m *Mnemonic) EntropyToMnemonicBuffer(entropy []byte, opts ...SecretBufferOption) (*SecretBuffer, error) {
+	if !isValidEntropyBits(len(entropy) * 8) {
+		return nil, ErrInvalidEntropy
+	}
+	if m.validator != nil {
+		if err := m.validator(entropy); err != nil {
+			return nil, err
+		}
+	}
+	hash := sha256.Sum256(entropy)
+	defer clear(hash[:])
+	var buf [maxEntropyWithChecksumSize]byte
+	defer clear(buf[:])
+	copy(buf[:], entropy)
+	buf[len(entropy)] = hash[0]
+
+	indexes := make([]int, len(entropy)*3/4)
+	defer clear(indexes)
+	size := len(m.delimiter) * (len(indexes) - 1)
+	for i := range indexes {
+		indexes[i] = extractBits(buf[:], i*bitsPerWord, bitsPerWord)
+		size += len(m.wordList[indexes[i]])
+	}
+	b, err := newSecretBuffer(size, opts)
+	if err != nil {
+		return nil, err
+	}
+	// The buffer has the exact size, so appending never reallocates it.
+	mnemonic := b.bytes()[:0]
+	for i, index := range indexes {
+		if i > 0 {
+			mnemonic = append(mnemonic, m.delimiter...)
+		}
+		mnemonic = append(mnemonic, m.wordList[index]...)
+	}
+	return b, nil
+}
+
+// appendNFKD appends the NFKD form of src to dst. When dst grows, the previous array is wiped,
+// and so is the buffer of the normalization, so that no partial copy is left behind.
+func appendNFKD(dst, src []byte) []byte {
+	it := new(norm.Iter)
+	it.Init(norm.NFKD, src)
+	for !it.Done() {
+		segment := it.Next()
+		if len(dst)+len(segment) > cap(dst) {
+			grown := make([]byte, len(dst), 2*cap(dst)+len(segment))
+			copy(grown, dst)
+			clear(dst[:cap(dst)])
+			dst = grown
+		}
+		dst = append(dst, segment...)
+	}
+	*it = norm.Iter{}
+	// Keep the wiped iterator alive, so that the compiler does not drop the wipe as a dead store.
+	runtime.KeepAlive(it)
+	return dst
+}
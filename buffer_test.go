@@ -0,0 +1,181 @@
+package bip39
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestNewSeedBuffer(t *testing.T) {
+	tests := []struct {
+		mnemonic   string
+		passphrase string
+	}{
+		{"legal winner thank year wave sausage worth useful legal winner thank yellow", "TREZOR"},
+		{"そつう　れきだい　ほんやく　わかす　りくつ　ばいか　ろせん　やちん　そつう　れきだい　ほんやく　わかめ", "㍍ガバヴァぱばぐゞちぢ十人十色"},
+		{"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", ""},
+	}
+	for _, test := range tests {
+		seed, err := NewSeedBuffer([]byte(test.mnemonic), []byte(test.passphrase))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(seed.Bytes(), NewSeed(test.mnemonic, WithPassphrase(test.passphrase))) {
+			t.Fatalf("%s: invalid seed %x", test.mnemonic, seed.Bytes())
+		}
+		if s := fmt.Sprintf("%x %v", seed, seed); s != "[REDACTED] [REDACTED]" {
+			t.Fatalf("leaked %s", s)
+		}
+		seed.Wipe()
+		if seed.Len() != 64 || !bytes.Equal(seed.Bytes(), make([]byte, 64)) {
+			t.Fatalf("invalid wiped seed %x", seed.Bytes())
+		}
+		if err := seed.Destroy(); err != nil {
+			t.Fatal(err)
+		}
+		if err := seed.Destroy(); err != nil || seed.Len() != 0 {
+			t.Fatalf("invalid destroyed seed %v %d", err, seed.Len())
+		}
+	}
+}
+
+func TestEntropyBuffer(t *testing.T) {
+	for _, language := range []Language{English, Japanese, Korean} {
+		m, err := NewMnemonic(WithLanguage(language))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, size := range []int{16, 20, 24, 28, 32} {
+			entropy := bytes.Repeat([]byte{byte(size), 0x5a, 0xc3, 0x17}, size/4)
+			mnemonic, err := m.EntropyToMnemonic(entropy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			buffer, err := m.EntropyToMnemonicBuffer(entropy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(buffer.Bytes()) != mnemonic {
+				t.Fatalf("%s: invalid mnemonic %s", language, buffer.Bytes())
+			}
+			decoded, err := m.EntropyFromMnemonicBuffer(buffer.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(decoded.Bytes(), entropy) {
+				t.Fatalf("%s: invalid entropy %x", language, decoded.Bytes())
+			}
+		}
+	}
+
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.EntropyFromMnemonicBuffer([]byte("abandon abandon abandon")); !errors.Is(err, ErrInvalidNumberWords) {
+		t.Fatalf("invalid error %v", err)
+	}
+	if _, err := m.EntropyFromMnemonicBuffer([]byte(strings.Repeat("abandon ", 12))); !errors.Is(err, ErrChecksumIncorrect) {
+		t.Fatalf("invalid error %v", err)
+	}
+	// The errors are the same as those of EntropyFromMnemonic.
+	for _, mnemonic := range []string{"abandon abandon abandon", strings.Repeat("abandon ", 12), "zzz " + strings.Repeat("abandon ", 10) + "yyy"} {
+		_, expected := m.EntropyFromMnemonic(mnemonic)
+		_, err := m.EntropyFromMnemonicBuffer([]byte(mnemonic))
+		var mnemonicErr *MnemonicError
+		if !errors.As(err, &mnemonicErr) || err.Error() != expected.Error() {
+			t.Fatalf("invalid error %v, expected %v", err, expected)
+		}
+	}
+	_, err = m.EntropyFromMnemonicBuffer([]byte("zzz " + strings.Repeat("abandon ", 10) + "yyy"))
+	var mnemonicErr *MnemonicError
+	if !errors.Is(err, ErrInvalidMnemonic) || !errors.As(err, &mnemonicErr) ||
+		!slices.Equal(mnemonicErr.Words, []WordError{{Position: 0, Word: "zzz"}, {Position: 11, Word: "yyy"}}) {
+		t.Fatalf("invalid error %v", err)
+	}
+	if _, err := m.EntropyToMnemonicBuffer(make([]byte, 15)); !errors.Is(err, ErrInvalidEntropy) {
+		t.Fatalf("invalid error %v", err)
+	}
+}
+
+func TestSecretBufferMemoryLock(t *testing.T) {
+	seed, err := NewSeedBuffer([]byte("legal winner thank year wave sausage worth useful legal winner thank yellow"), []byte("TREZOR"), WithMemoryLock())
+	if errors.Is(err, ErrMemoryLock) {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !seed.Locked() || !bytes.Equal(seed.Bytes(), NewSeed("legal winner thank year wave sausage worth useful legal winner thank yellow", WithPassphrase("TREZOR"))) {
+		t.Fatalf("invalid locked seed %v %x", seed.Locked(), seed.Bytes())
+	}
+	if err := seed.Destroy(); err != nil {
+		t.Fatal(err)
+	}
+	if seed.Locked() || seed.Len() != 0 {
+		t.Fatal("seed not destroyed")
+	}
+}
+
+func TestSecretBufferCleanup(t *testing.T) {
+	// The locked memory is reported by Linux in /proc/self/status.
+	lockedKB := func() int {
+		status, err := os.ReadFile("/proc/self/status")
+		if err != nil {
+			t.Skip(err)
+		}
+		for line := range strings.Lines(string(status)) {
+			if value, ok := strings.CutPrefix(line, "VmLck:"); ok {
+				kb, err := strconv.Atoi(strings.TrimSuffix(strings.TrimSpace(value), " kB"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				return kb
+			}
+		}
+		t.Skip("VmLck not reported")
+		return 0
+	}
+	before := lockedKB()
+	func() {
+		seed, err := NewSeedBuffer([]byte("legal winner thank year wave sausage worth useful legal winner thank yellow"), nil, WithMemoryLock())
+		if errors.Is(err, ErrMemoryLock) {
+			t.Skip(err)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !seed.Locked() || lockedKB() <= before {
+			t.Fatal("memory not locked")
+		}
+	}()
+	// The buffer was dropped without Destroy, the garbage collector frees its memory.
+	for range 100 {
+		runtime.GC()
+		if lockedKB() == before {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("%d kB still locked, %d kB before", lockedKB(), before)
+}
+
+func TestAppendNFKD(t *testing.T) {
+	// ㍍ expands from 3 to 12 bytes, which grows the buffer several times.
+	src := []byte(strings.Repeat("㍍가", 20))
+	if got := appendNFKD(nil, src); !bytes.Equal(got, norm.NFKD.Bytes(src)) {
+		t.Fatalf("invalid NFKD %q", got)
+	}
+	if got := appendNFKD([]byte("mnemonic"), []byte("ガ")); !bytes.Equal(got, []byte(norm.NFKD.String("mnemonicガ"))) {
+		t.Fatalf("invalid NFKD %q", got)
+	}
+}
@@ -0,0 +1,436 @@
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/gofika/bip39"
+	"golang.org/x/term"
+)
+
+const (
+	exitOK                   = 0
+	exitError                = 1
+	exitUsage                = 2
+	exitInvalidEntropy       = 3
+	exitInvalidMnemonic      = 4
+	exitInvalidNumberWords   = 5
+	exitInvalidNumberEntropy = 6
+	exitChecksumIncorrect    = 7
+)
+
+// exitCodes maps the sentinel errors of the bip39 package to exit codes.
+var exitCodes = []struct {
+	err  error
+	code int
+}{
+	{bip39.ErrInvalidEntropy, exitInvalidEntropy},
+	{bip39.ErrInvalidMnemonic, exitInvalidMnemonic},
+	{bip39.ErrInvalidNumberWords, exitInvalidNumberWords},
+	{bip39.ErrInvalidNumberEntropy, exitInvalidNumberEntropy},
+	{bip39.ErrChecksumIncorrect, exitChecksumIncorrect},
+}
+
+var errUsage = errors.New("usage error")
+
+func exitCode(err error) int {
+	if err == nil {
+		return exitOK
+	}
+	if errors.Is(err, errUsage) {
+		return exitUsage
+	}
+	for _, e := range exitCodes {
+		if errors.Is(err, e.err) {
+			return e.code
+		}
+	}
+	return exitError
+}
+
+type command struct {
+	name    string
+	summary string
+	run     func(env *env, args []string) error
+}
+
+var commands = []command{
+	{"generate", "generate a new mnemonic", runGenerate},
+	{"validate", "validate a mnemonic read from stdin", runValidate},
+	{"detect", "detect the languages of a mnemonic read from stdin", runDetect},
+	{"to-entropy", "convert a mnemonic read from stdin to hex entropy", runToEntropy},
+	{"from-entropy", "convert hex entropy read from stdin to a mnemonic", runFromEntropy},
+	{"seed", "derive the hex seed of a mnemonic read from stdin", runSeed},
+}
+
+// env holds the streams of a command and its output format.
+type env struct {
+	stdin *bufio.Reader
+	// terminal is the file descriptor of stdin if stdin is a terminal, -1 otherwise.
+	terminal int
+	stdout   io.Writer
+	stderr   io.Writer
+	json     bool
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	e := &env{
+		stdin:    bufio.NewReader(stdin),
+		terminal: -1,
+		stdout:   stdout,
+		stderr:   stderr,
+	}
+	if f, ok := stdin.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		e.terminal = int(f.Fd())
+	}
+
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "-help" || args[0] == "--help" {
+		printUsage(stderr)
+		if len(args) == 0 {
+			return exitUsage
+		}
+		return exitOK
+	}
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			err := cmd.run(e, args[1:])
+			if err != nil && !errors.Is(err, flag.ErrHelp) {
+				var silent silentError
+				if !errors.Is(err, errUsage) && !errors.As(err, &silent) {
+					fmt.Fprintf(stderr, "bip39 %s: %v\n", cmd.name, err)
+				}
+				return exitCode(err)
+			}
+			return exitOK
+		}
+	}
+	fmt.Fprintf(stderr, "bip39: unknown command %q\n", args[0])
+	printUsage(stderr)
+	return exitUsage
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: bip39 <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-13s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Run 'bip39 <command> -h' for the flags of a command.")
+}
+
+// newFlagSet returns a flag set with the -json flag shared by all commands.
+func newFlagSet(e *env, name string) *flag.FlagSet {
+	fs := flag.NewFlagSet("bip39 "+name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	fs.BoolVar(&e.json, "json", false, "print the result as JSON")
+	return fs
+}
+
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return errUsage
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(fs.Output(), "%s: unexpected arguments, secrets are read from stdin\n", fs.Name())
+		return errUsage
+	}
+	return nil
+}
+
+// languageFlag is a flag.Value holding an optional language.
+type languageFlag struct {
+	lang bip39.Language
+	set  bool
+}
+
+func (f *languageFlag) String() string {
+	if !f.set {
+		return ""
+	}
+	return f.lang.String()
+}
+
+func (f *languageFlag) Set(name string) error {
+	lang, ok := bip39.ParseLanguage(name)
+	if !ok {
+		return fmt.Errorf("unknown language %q", name)
+	}
+	f.lang, f.set = lang, true
+	return nil
+}
+
+// readSecret reads a line from stdin. If stdin is a terminal, the prompt is printed
+// to stderr and the line is read without echo.
+func (e *env) readSecret(prompt string) (string, error) {
+	if e.terminal >= 0 {
+		fmt.Fprint(e.stderr, prompt)
+		line, err := term.ReadPassword(e.terminal)
+		fmt.Fprintln(e.stderr)
+		return string(line), err
+	}
+	line, err := e.stdin.ReadString('\n')
+	if err != nil && !(errors.Is(err, io.EOF) && line != "") {
+		if errors.Is(err, io.EOF) {
+			return "", fmt.Errorf("%s: no input on stdin", strings.TrimSuffix(prompt, ": "))
+		}
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// print prints the result as JSON if -json is set, or the plain text otherwise.
+func (e *env) print(result any, plain string) error {
+	if e.json {
+		enc := json.NewEncoder(e.stdout)
+		return enc.Encode(result)
+	}
+	_, err := fmt.Fprintln(e.stdout, plain)
+	return err
+}
+
+func languageNames(languages []bip39.Language) []string {
+	names := make([]string, len(languages))
+	for i, lang := range languages {
+		names[i] = lang.String()
+	}
+	return names
+}
+
+// detectLanguages returns the languages of the mnemonic, in a stable order.
+func detectLanguages(mnemonic string) ([]bip39.Language, error) {
+	languages, ok := bip39.DetectLanguage(mnemonic)
+	if !ok {
+		return nil, bip39.ErrInvalidMnemonic
+	}
+	slices.Sort(languages)
+	return languages, nil
+}
+
+// mnemonicEntropy returns the entropy and language of the mnemonic.
+// If no language is given, the first detected language the mnemonic is valid in is used.
+func mnemonicEntropy(mnemonic string, language languageFlag) ([]byte, bip39.Language, error) {
+	languages := []bip39.Language{language.lang}
+	if !language.set {
+		var err error
+		languages, err = detectLanguages(mnemonic)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	var firstErr error
+	for _, lang := range languages {
+		m, err := bip39.NewMnemonic(bip39.WithLanguage(lang))
+		if err != nil {
+			return nil, 0, err
+		}
+		entropy, err := m.EntropyFromMnemonic(mnemonic)
+		if err == nil {
+			return entropy, lang, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return nil, 0, firstErr
+}
+
+func runGenerate(e *env, args []string) error {
+	fs := newFlagSet(e, "generate")
+	language := languageFlag{lang: bip39.English, set: true}
+	fs.Var(&language, "lang", "language of the mnemonic")
+	bits := fs.Int("bits", 128, "entropy bits: 128, 160, 192, 224 or 256")
+	words := fs.Int("words", 0, "number of words: 12, 15, 18, 21 or 24, overrides -bits")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	entropyBits := *bits
+	wordsSet := *words != 0
+	if wordsSet {
+		// Each word holds 11 bits, one of every 33 bits is checksum.
+		if *words%3 != 0 {
+			return bip39.ErrInvalidNumberWords
+		}
+		entropyBits = *words / 3 * 32
+	}
+
+	m, err := bip39.NewMnemonic(bip39.WithLanguage(language.lang))
+	if err != nil {
+		return err
+	}
+	mnemonic, err := m.GenerateMnemonic(bip39.WithEntropyBits(entropyBits))
+	if err != nil {
+		if wordsSet && errors.Is(err, bip39.ErrInvalidEntropy) {
+			return bip39.ErrInvalidNumberWords
+		}
+		return err
+	}
+	wordList, _ := bip39.SplitMnemonic(mnemonic)
+	return e.print(struct {
+		Mnemonic string `json:"mnemonic"`
+		Language string `json:"language"`
+		Words    int    `json:"words"`
+	}{mnemonic, language.lang.String(), len(wordList)}, mnemonic)
+}
+
+func runValidate(e *env, args []string) error {
+	fs := newFlagSet(e, "validate")
+	var language languageFlag
+	fs.Var(&language, "lang", "language of the mnemonic, detected if not set")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	mnemonic, err := e.readSecret("Mnemonic: ")
+	if err != nil {
+		return err
+	}
+	_, lang, err := mnemonicEntropy(mnemonic, language)
+	result := struct {
+		Valid    bool   `json:"valid"`
+		Language string `json:"language,omitempty"`
+		Error    string `json:"error,omitempty"`
+	}{Valid: err == nil}
+	plain := "valid"
+	if err != nil {
+		result.Error = err.Error()
+		plain = "invalid: " + err.Error()
+	} else {
+		result.Language = lang.String()
+	}
+	if printErr := e.print(result, plain); printErr != nil {
+		return printErr
+	}
+	// The result is already printed, only the exit code is left to report.
+	if err != nil {
+		return silentError{err}
+	}
+	return nil
+}
+
+func runDetect(e *env, args []string) error {
+	fs := newFlagSet(e, "detect")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	mnemonic, err := e.readSecret("Mnemonic: ")
+	if err != nil {
+		return err
+	}
+	languages, err := detectLanguages(mnemonic)
+	if err != nil {
+		return err
+	}
+	names := languageNames(languages)
+	return e.print(struct {
+		Languages []string `json:"languages"`
+	}{names}, strings.Join(names, "\n"))
+}
+
+func runToEntropy(e *env, args []string) error {
+	fs := newFlagSet(e, "to-entropy")
+	var language languageFlag
+	fs.Var(&language, "lang", "language of the mnemonic, detected if not set")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	mnemonic, err := e.readSecret("Mnemonic: ")
+	if err != nil {
+		return err
+	}
+	entropy, lang, err := mnemonicEntropy(mnemonic, language)
+	if err != nil {
+		return err
+	}
+	return e.print(struct {
+		Entropy  string `json:"entropy"`
+		Language string `json:"language"`
+	}{hex.EncodeToString(entropy), lang.String()}, hex.EncodeToString(entropy))
+}
+
+func runFromEntropy(e *env, args []string) error {
+	fs := newFlagSet(e, "from-entropy")
+	language := languageFlag{lang: bip39.English, set: true}
+	fs.Var(&language, "lang", "language of the mnemonic")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	line, err := e.readSecret("Entropy (hex): ")
+	if err != nil {
+		return err
+	}
+	entropy, err := hex.DecodeString(strings.TrimSpace(line))
+	if err != nil {
+		return fmt.Errorf("%w: %v", bip39.ErrInvalidEntropy, err)
+	}
+	m, err := bip39.NewMnemonic(bip39.WithLanguage(language.lang))
+	if err != nil {
+		return err
+	}
+	mnemonic, err := m.EntropyToMnemonic(entropy)
+	if err != nil {
+		return err
+	}
+	return e.print(struct {
+		Mnemonic string `json:"mnemonic"`
+		Language string `json:"language"`
+	}{mnemonic, language.lang.String()}, mnemonic)
+}
+
+func runSeed(e *env, args []string) error {
+	fs := newFlagSet(e, "seed")
+	passphrase := fs.Bool("passphrase", false, "read a passphrase after the mnemonic, prompting for it twice on a terminal")
+	noCheck := fs.Bool("no-check", false, "derive the seed without validating the mnemonic")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	mnemonic, err := e.readSecret("Mnemonic: ")
+	if err != nil {
+		return err
+	}
+	if !*noCheck {
+		if _, _, err := mnemonicEntropy(mnemonic, languageFlag{}); err != nil {
+			return err
+		}
+	}
+	var opts []bip39.NewSeedOption
+	if *passphrase {
+		p, err := e.readSecret("Passphrase: ")
+		if err != nil {
+			return err
+		}
+		if e.terminal >= 0 {
+			confirm, err := e.readSecret("Passphrase (again): ")
+			if err != nil {
+				return err
+			}
+			if confirm != p {
+				return errors.New("passphrases do not match")
+			}
+		}
+		opts = append(opts, bip39.WithPassphrase(p))
+	}
+	seed := hex.EncodeToString(bip39.NewSeed(mnemonic, opts...))
+	return e.print(struct {
+		Seed string `json:"seed"`
+	}{seed}, seed)
+}
+
+// silentError is an error whose message was already reported as part of the result.
+type silentError struct {
+	err error
+}
+
+func (e silentError) Error() string { return e.err.Error() }
+func (e silentError) Unwrap() error { return e.err }
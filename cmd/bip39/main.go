@@ -0,0 +1,38 @@
+// Command bip39 generates, validates and converts BIP39 mnemonics.
+//
+// Usage:
+//
+//	bip39 <command> [flags]
+//
+// The commands are:
+//
+//	generate      generate a new mnemonic
+//	validate      validate a mnemonic read from stdin
+//	detect        detect the languages of a mnemonic read from stdin
+//	to-entropy    convert a mnemonic read from stdin to hex entropy
+//	from-entropy  convert hex entropy read from stdin to a mnemonic
+//	seed          derive the hex seed of a mnemonic read from stdin
+//
+// Secrets are never accepted as arguments, so that they do not end up in the shell history
+// or the process list. They are read from stdin, one per line, and typed without echo
+// when stdin is a terminal.
+//
+// Every command accepts -json to print the result as a JSON object.
+//
+// The exit status is 0 on success, 2 for usage errors, and otherwise identifies the error:
+//
+//	1  other errors
+//	3  invalid entropy (bip39.ErrInvalidEntropy)
+//	4  invalid mnemonic (bip39.ErrInvalidMnemonic)
+//	5  invalid number of words (bip39.ErrInvalidNumberWords)
+//	6  invalid number of entropy (bip39.ErrInvalidNumberEntropy)
+//	7  checksum incorrect (bip39.ErrChecksumIncorrect)
+package main
+
+import (
+	"os"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func runCommand(t *testing.T, stdin string, args ...string) (code int, stdout, stderr string) {
+	t.Helper()
+	var out, errOut bytes.Buffer
+	code = run(args, strings.NewReader(stdin), &out, &errOut)
+	return code, out.String(), errOut.String()
+}
+
+func TestGenerate(t *testing.T) {
+	code, stdout, _ := runCommand(t, "", "generate", "-words", "24", "-json")
+	if code != exitOK {
+		t.Fatalf("unexpected exit code %d", code)
+	}
+	var result struct {
+		Mnemonic string `json:"mnemonic"`
+		Language string `json:"language"`
+		Words    int    `json:"words"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatal(err)
+	}
+	if result.Language != "english" || result.Words != 24 || len(strings.Fields(result.Mnemonic)) != 24 {
+		t.Fatalf("invalid result %+v", result)
+	}
+
+	code, stdout, _ = runCommand(t, "", "generate", "-lang", "japanese")
+	if code != exitOK {
+		t.Fatalf("unexpected exit code %d", code)
+	}
+	code, _, _ = runCommand(t, stdout, "validate")
+	if code != exitOK {
+		t.Fatalf("unexpected exit code %d", code)
+	}
+
+	if code, _, _ = runCommand(t, "", "generate", "-bits", "100"); code != exitInvalidEntropy {
+		t.Fatalf("unexpected exit code %d", code)
+	}
+	if code, _, _ = runCommand(t, "", "generate", "-words", "13"); code != exitInvalidNumberWords {
+		t.Fatalf("unexpected exit code %d", code)
+	}
+	if code, _, _ = runCommand(t, "", "generate", "-lang", "klingon"); code != exitUsage {
+		t.Fatalf("unexpected exit code %d", code)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		mnemonic string
+		code     int
+	}{
+		{testMnemonic, exitOK},
+		{strings.Replace(testMnemonic, "about", "abandon", 1), exitChecksumIncorrect},
+		{"abandon abandon about", exitInvalidNumberWords},
+		{"abandon abandon notaword", exitInvalidMnemonic},
+	}
+	for _, test := range tests {
+		code, stdout, _ := runCommand(t, test.mnemonic+"\n", "validate", "-json")
+		if code != test.code {
+			t.Fatalf("%s: unexpected exit code %d", test.mnemonic, code)
+		}
+		var result struct {
+			Valid bool `json:"valid"`
+		}
+		if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+			t.Fatal(err)
+		}
+		if result.Valid != (test.code == exitOK) {
+			t.Fatalf("%s: unexpected result %s", test.mnemonic, stdout)
+		}
+	}
+}
+
+func TestDetect(t *testing.T) {
+	code, stdout, _ := runCommand(t, "露 水 域 耀 搜 船 良 摘 士 近 桃 案", "detect")
+	if code != exitOK || stdout != "chinese_simplified\nchinese_traditional\n" {
+		t.Fatalf("unexpected result %d %q", code, stdout)
+	}
+}
+
+func TestEntropy(t *testing.T) {
+	code, stdout, _ := runCommand(t, testMnemonic, "to-entropy")
+	if code != exitOK || stdout != "00000000000000000000000000000000\n" {
+		t.Fatalf("unexpected result %d %q", code, stdout)
+	}
+	code, stdout, _ = runCommand(t, "00000000000000000000000000000000\n", "from-entropy")
+	if code != exitOK || stdout != testMnemonic+"\n" {
+		t.Fatalf("unexpected result %d %q", code, stdout)
+	}
+	if code, _, _ = runCommand(t, "0000", "from-entropy"); code != exitInvalidEntropy {
+		t.Fatalf("unexpected exit code %d", code)
+	}
+	if code, _, _ = runCommand(t, "zz", "from-entropy"); code != exitInvalidEntropy {
+		t.Fatalf("unexpected exit code %d", code)
+	}
+}
+
+func TestSeed(t *testing.T) {
+	// https://github.com/trezor/python-mnemonic/blob/master/vectors.json
+	code, stdout, _ := runCommand(t, testMnemonic+"\nTREZOR\n", "seed", "-passphrase")
+	if code != exitOK || stdout != "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04\n" {
+		t.Fatalf("unexpected result %d %q", code, stdout)
+	}
+	if code, _, _ = runCommand(t, testMnemonic, "seed", "-passphrase"); code != exitError {
+		t.Fatalf("unexpected exit code %d", code)
+	}
+	if code, _, _ = runCommand(t, "", "seed"); code != exitError {
+		t.Fatalf("unexpected exit code %d", code)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	if code, _, _ := runCommand(t, ""); code != exitUsage {
+		t.Fatalf("unexpected exit code %d", code)
+	}
+	if code, _, _ := runCommand(t, "", "unknown"); code != exitUsage {
+		t.Fatalf("unexpected exit code %d", code)
+	}
+	// Secrets are not accepted as arguments.
+	if code, _, _ := runCommand(t, "", "validate", testMnemonic); code != exitUsage {
+		t.Fatalf("unexpected exit code %d", code)
+	}
+	if code, _, _ := runCommand(t, "", "seed", "-h"); code != exitOK {
+		t.Fatalf("unexpected exit code %d", code)
+	}
+}
@@ -0,0 +1,173 @@
+package bip39
+
+import (
+	"errors"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+)
+
+// LanguageRanking is the result of RankLanguages.
+type LanguageRanking struct {
+	// Matches are the possible languages, the most likely first. Languages as likely are in ascending order.
+	Matches []LanguageMatch
+	// Words is the number of words of the mnemonic, including the last one if it is being typed.
+	Words int
+	// Complete reports whether the checksum was checked, as the mnemonic has a valid number of words
+	// that identify one word each in at least one language.
+	Complete bool
+}
+
+// LanguageMatch is a possible language of a mnemonic.
+type LanguageMatch struct {
+	Language Language
+	// Confidence is the probability of the language given the words, from 0 to 1.
+	// The confidences of the matches add up to 1.
+	Confidence float64
+	// ChecksumValid reports whether the checksum was checked and is valid in the language.
+	ChecksumValid bool
+}
+
+// Language returns the language of the mnemonic once it is the only possible one, so that a UI can lock it in.
+func (r *LanguageRanking) Language() (Language, bool) {
+	if len(r.Matches) != 1 {
+		return 0, false
+	}
+	return r.Matches[0].Language, true
+}
+
+// RankLanguages ranks the possible languages of a mnemonic, complete or being typed, by confidence.
+//
+// The words are matched as DetectLanguage does, except the last word, which is matched as a prefix too
+// unless the mnemonic ends with a delimiter, as it may not be typed completely yet.
+// With WithPrefixDetection() option, every word is matched as a prefix.
+//
+// The confidence of a language is the probability that a random mnemonic of the language has the words,
+// normalized over the possible languages: a word counts for 1/2048 and a prefix for the number of words
+// it starts divided by 2048. When the mnemonic has a valid number of words, each identifying one word,
+// the checksum is checked: the languages in which it fails are dropped, and the others are 2^(words/3) times
+// as likely, as only 1 mnemonic in 2^(words/3) has a valid checksum.
+// Words at the same indexes in several languages, such as many in ChineseSimplified and ChineseTraditional,
+// leave them as likely.
+//
+// Example:
+//
+//	ranking := RankLanguages("abandon amateur angle animal aspect badge bicycle bonus brave canal capable abandon")
+//	language, ok := ranking.Language() // English, true: the checksum fails in French
+func RankLanguages(mnemonic string, opts ...DetectLanguageOption) *LanguageRanking {
+	options := &DetectLanguageOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	possible := allLanguages
+	if len(options.languages) > 0 {
+		possible = newLanguageSet(options.languages)
+	}
+	last, _ := utf8.DecodeLastRuneInString(mnemonic)
+	typing := mnemonic != "" && !isWordDelimiter(last) && !unicode.IsSpace(last)
+	words, delimiter := SplitMnemonic(mnemonic)
+	if delimiter == string(japaneseSpace) {
+		possible &= 1 << Japanese
+	}
+
+	ranking := &LanguageRanking{Words: len(words)}
+	var buf [maxEntropyWithChecksumSize]byte
+	var likelihoods []float64
+	resolved := make([]string, len(words))
+	for language, data := range registry().all() {
+		if !possible.has(language) {
+			continue
+		}
+		likelihood := 1.0
+		identified := true
+		for i, word := range words {
+			if !options.prefixes && (!typing || i < len(words)-1) {
+				if _, ok := data.wordsMap[word]; !ok {
+					likelihood = 0
+					break
+				}
+				likelihood /= float64(len(data.words))
+				resolved[i] = word
+				continue
+			}
+			start, end := data.index.lookup(word)
+			if start == end {
+				likelihood = 0
+				break
+			}
+			likelihood *= float64(end-start) / float64(len(data.words))
+			// A whole word identifies itself, even if it is the prefix of other words.
+			if _, ok := data.wordsMap[word]; ok {
+				resolved[i] = word
+			} else if end-start == 1 {
+				resolved[i] = data.index.wordlist[data.index.positions[start]]
+			} else {
+				identified = false
+			}
+		}
+		if likelihood == 0 {
+			continue
+		}
+		match := LanguageMatch{Language: language}
+		if isValidWordsSize(len(words)) && identified {
+			if checkWords(data.wordsMap, resolved, buf[:]) != nil {
+				continue
+			}
+			likelihood *= float64(uint(1) << (len(words) / 3))
+			match.ChecksumValid = true
+			ranking.Complete = true
+		}
+		ranking.Matches = append(ranking.Matches, match)
+		likelihoods = append(likelihoods, likelihood)
+	}
+
+	total := 0.0
+	for _, likelihood := range likelihoods {
+		total += likelihood
+	}
+	for i := range ranking.Matches {
+		ranking.Matches[i].Confidence = likelihoods[i] / total
+	}
+	// The sort is stable so that languages as likely stay in ascending order.
+	slices.SortStableFunc(ranking.Matches, func(a, b LanguageMatch) int {
+		switch {
+		case a.Confidence > b.Confidence:
+			return -1
+		case a.Confidence < b.Confidence:
+			return 1
+		}
+		return 0
+	})
+	return ranking
+}
+
+// dropInvalidChecksums removes the languages in which the checksum of the mnemonic fails from possible,
+// if the mnemonic has a valid number of words. With prefixes, the languages in which a prefix is unknown
+// are removed too, and those in which a prefix is ambiguous are kept, as the checksum cannot be checked.
+func dropInvalidChecksums(mnemonic string, possible languageSet, prefixes bool) languageSet {
+	words, _ := SplitMnemonic(mnemonic)
+	if !isValidWordsSize(len(words)) {
+		return possible
+	}
+	var buf [maxEntropyWithChecksumSize]byte
+	for language, data := range registry().all() {
+		if !possible.has(language) {
+			continue
+		}
+		resolved := words
+		if prefixes {
+			expanded, err := expandPrefixes(data.wordsMap, data.index, words)
+			if errors.Is(err, ErrUnknownPrefix) {
+				possible &^= 1 << language
+			}
+			if err != nil {
+				continue
+			}
+			resolved = expanded
+		}
+		if checkWords(data.wordsMap, resolved, buf[:]) != nil {
+			possible &^= 1 << language
+		}
+	}
+	return possible
+}
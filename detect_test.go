@@ -0,0 +1,96 @@
+package bip39
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWithChecksumDetection(t *testing.T) {
+	// The words are both English and French, with other indexes in French.
+	const shared = "abandon amateur angle animal aspect badge bicycle bonus brave canal capable abandon"
+	languages, ok := DetectLanguage(shared)
+	if !ok || !slices.Equal(languages, []Language{English, French}) {
+		t.Fatalf("invalid languages %v", languages)
+	}
+	languages, ok = DetectLanguage(shared, WithChecksumDetection())
+	if !ok || !slices.Equal(languages, []Language{English}) {
+		t.Fatalf("invalid languages %v", languages)
+	}
+	// Prefixes are expanded before the checksum is checked.
+	languages, ok = DetectLanguage("lega winn than year wave saus wort usef lega winn than yell", WithPrefixDetection(), WithChecksumDetection())
+	if !ok || !slices.Equal(languages, []Language{English}) {
+		t.Fatalf("invalid languages %v", languages)
+	}
+	// Without a valid number of words, the checksum is not checked.
+	languages, ok = DetectLanguage("abandon amateur angle", WithChecksumDetection())
+	if !ok || !slices.Equal(languages, []Language{English, French}) {
+		t.Fatalf("invalid languages %v", languages)
+	}
+	if _, ok := DetectLanguage("abandon amateur angle animal aspect badge bicycle bonus brave canal capable amateur", WithChecksumDetection()); ok {
+		t.Fatal("checksum invalid in both languages")
+	}
+}
+
+func TestRankLanguages(t *testing.T) {
+	const shared = "abandon amateur angle animal aspect badge bicycle bonus brave canal capable abandon"
+	ranking := RankLanguages(shared)
+	if language, ok := ranking.Language(); !ok || language != English || !ranking.Complete || ranking.Words != 12 {
+		t.Fatalf("invalid ranking %+v", ranking)
+	}
+	if match := ranking.Matches[0]; match.Confidence != 1 || !match.ChecksumValid {
+		t.Fatalf("invalid match %+v", match)
+	}
+
+	// The last word is being typed: both languages have words starting with "ab".
+	ranking = RankLanguages("abandon ab")
+	if _, ok := ranking.Language(); ok || ranking.Complete || ranking.Words != 2 || len(ranking.Matches) != 2 {
+		t.Fatalf("invalid ranking %+v", ranking)
+	}
+	total := 0.0
+	for _, match := range ranking.Matches {
+		total += match.Confidence
+		if match.ChecksumValid {
+			t.Fatalf("checksum not checked %+v", match)
+		}
+	}
+	if total < 0.999 || total > 1.001 {
+		t.Fatalf("confidences add up to %f", total)
+	}
+	// French has more words starting with "ab", so it is more likely.
+	if ranking.Matches[0].Language != French || ranking.Matches[0].Confidence <= ranking.Matches[1].Confidence {
+		t.Fatalf("invalid order %+v", ranking.Matches)
+	}
+	// Followed by a delimiter, the word is complete.
+	if ranking = RankLanguages("abandon ab "); len(ranking.Matches) != 0 {
+		t.Fatalf("invalid ranking %+v", ranking)
+	}
+	// A word unique to a language locks it in.
+	ranking = RankLanguages("abandon abov")
+	if language, ok := ranking.Language(); !ok || language != English {
+		t.Fatalf("invalid ranking %+v", ranking)
+	}
+
+	// Languages as likely are in ascending order.
+	ranking = RankLanguages("abandon amateur")
+	if len(ranking.Matches) != 2 || ranking.Matches[0].Language != English || ranking.Matches[0].Confidence != 0.5 {
+		t.Fatalf("invalid ranking %+v", ranking)
+	}
+	ranking = RankLanguages("")
+	if len(ranking.Matches) != int(languageCount) || ranking.Words != 0 {
+		t.Fatalf("invalid ranking %+v", ranking)
+	}
+	ranking = RankLanguages("ab", WithLanguages([]Language{English, Spanish}))
+	if len(ranking.Matches) != 2 {
+		t.Fatalf("invalid ranking %+v", ranking)
+	}
+	ranking = RankLanguages("おさえる　けむり")
+	if language, ok := ranking.Language(); !ok || language != Japanese {
+		t.Fatalf("invalid ranking %+v", ranking)
+	}
+
+	// Prefixes identifying one word each are checked too.
+	ranking = RankLanguages("lega winn than year wave saus wort usef lega winn than yell", WithPrefixDetection())
+	if language, ok := ranking.Language(); !ok || language != English || !ranking.Complete {
+		t.Fatalf("invalid ranking %+v", ranking)
+	}
+}
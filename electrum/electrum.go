@@ -0,0 +1,262 @@
+// Package electrum implements Electrum v2 seed phrases.
+//
+// Electrum seeds use the same English wordlist as BIP39, but no checksum. Instead the
+// seed type is encoded in the prefix of HMAC-SHA512("Seed version", phrase), and the
+// seed is derived with the PBKDF2 salt "electrum" instead of "mnemonic". As a result an
+// Electrum phrase is usually rejected by bip39.IsMnemonicValid, and a BIP39 phrase
+// restored in Electrum, or the other way around, gives a different wallet.
+package electrum
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/gofika/bip39"
+	"github.com/gofika/bip39/wordlists"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+// SeedType is the type of an Electrum v2 seed, encoded in its version prefix.
+type SeedType byte
+
+const (
+	// Standard is the seed type of P2PKH wallets.
+	Standard SeedType = iota + 1
+	// Segwit is the seed type of native segwit P2WPKH wallets.
+	Segwit
+	// TwoFactor is the seed type of 2FA wallets.
+	TwoFactor
+	// TwoFactorSegwit is the seed type of segwit 2FA wallets.
+	TwoFactorSegwit
+)
+
+const (
+	// bitsPerWord is the number of bits encoded by each word of the 2048 words list.
+	bitsPerWord = 11
+	// defaultEntropyBits is the entropy of the 12 words seeds Electrum generates.
+	defaultEntropyBits = 132
+)
+
+var (
+	ErrInvalidSeedType    = errors.New("invalid seed type")
+	ErrInvalidEntropyBits = errors.New("invalid entropy bits")
+)
+
+// seedTypes are the seed types in the order Electrum detects them.
+var seedTypes = []SeedType{Standard, Segwit, TwoFactor, TwoFactorSegwit}
+
+// Prefix returns the hex prefix of HMAC-SHA512("Seed version", phrase) that identifies the seed type.
+func (t SeedType) Prefix() string {
+	switch t {
+	case Standard:
+		return "01"
+	case Segwit:
+		return "100"
+	case TwoFactor:
+		return "101"
+	case TwoFactorSegwit:
+		return "102"
+	}
+	return ""
+}
+
+// String returns the name Electrum uses for the seed type.
+func (t SeedType) String() string {
+	switch t {
+	case Standard:
+		return "standard"
+	case Segwit:
+		return "segwit"
+	case TwoFactor:
+		return "2fa"
+	case TwoFactorSegwit:
+		return "2fa_segwit"
+	}
+	return "unknown"
+}
+
+// DetectSeedType detects the Electrum v2 seed type of the mnemonic.
+// ok is false if the mnemonic is not an Electrum v2 seed.
+//
+// Electrum v1 ("old") seeds are not detected.
+// The words are not checked against the wordlist, as Electrum does not check them either.
+func DetectSeedType(mnemonic string) (seedType SeedType, ok bool) {
+	version := seedVersion(mnemonic)
+	for _, t := range seedTypes {
+		if strings.HasPrefix(version, t.Prefix()) {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+// IsSeedType reports whether the mnemonic is an Electrum v2 seed of the given type.
+func IsSeedType(mnemonic string, seedType SeedType) bool {
+	prefix := seedType.Prefix()
+	return prefix != "" && strings.HasPrefix(seedVersion(mnemonic), prefix)
+}
+
+// GenerateMnemonic generates a new Electrum v2 seed phrase of the given type from the English wordlist.
+//
+// The default entropy bits is 132, that is 12 words.
+// If you want to set the entropy bits, use WithEntropyBits() option.
+// If you want to set the source of the entropy, use WithRandom() option.
+func GenerateMnemonic(seedType SeedType, opts ...GenerateMnemonicOption) (string, error) {
+	options := &GenerateMnemonicOptions{
+		entropyBits: defaultEntropyBits,
+		random:      rand.Reader,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	prefix := seedType.Prefix()
+	if prefix == "" {
+		return "", ErrInvalidSeedType
+	}
+	if options.entropyBits < defaultEntropyBits {
+		return "", ErrInvalidEntropyBits
+	}
+	// Round up to whole words.
+	numBits := (options.entropyBits + bitsPerWord - 1) / bitsPerWord * bitsPerWord
+
+	// Draw entropy with a non-zero last word, so the phrase has numBits/11 words.
+	maxEntropy := new(big.Int).Lsh(big.NewInt(1), uint(numBits))
+	minEntropy := new(big.Int).Lsh(big.NewInt(1), uint(numBits-bitsPerWord))
+	entropy := new(big.Int)
+	for entropy.Cmp(minEntropy) < 0 {
+		var err error
+		entropy, err = rand.Int(options.random, maxEntropy)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	m, err := bip39.NewMnemonic()
+	if err != nil {
+		return "", err
+	}
+	// Increment a nonce until the phrase has the version prefix of the seed type.
+	one := big.NewInt(1)
+	for {
+		entropy.Add(entropy, one)
+		mnemonic := encodeMnemonic(entropy)
+		if !strings.HasPrefix(seedVersion(mnemonic), prefix) {
+			continue
+		}
+		// Like Electrum, reject phrases that happen to be valid BIP39 mnemonics too, to avoid ambiguity.
+		if _, err := m.EntropyFromMnemonic(mnemonic); err == nil {
+			continue
+		}
+		return mnemonic, nil
+	}
+}
+
+// NewSeed creates the 64 bytes Electrum seed from the mnemonic and an optional passphrase.
+// Both are normalized the way Electrum normalizes them, see NormalizeText.
+// No checking is performed to validate that the mnemonic is an Electrum seed, use DetectSeedType for that.
+func NewSeed(mnemonic string, opts ...NewSeedOption) []byte {
+	options := &NewSeedOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return pbkdf2.Key([]byte(NormalizeText(mnemonic)), []byte("electrum"+NormalizeText(options.passphrase)), 2048, 64, sha512.New)
+}
+
+// NormalizeText normalizes a mnemonic or passphrase the way Electrum does:
+// NFKD normalization, lower case, removal of combining marks, single spaces between words,
+// and no spaces between CJK characters.
+func NormalizeText(text string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(norm.NFKD.String(text)) {
+		// Remove accents, that is characters with a non-zero canonical combining class.
+		if norm.NFD.PropertiesString(string(r)).CCC() == 0 {
+			b.WriteRune(r)
+		}
+	}
+	words := strings.Fields(b.String())
+
+	b.Reset()
+	for i, word := range words {
+		if i > 0 {
+			prev := []rune(words[i-1])
+			next := []rune(word)
+			if !isCJK(prev[len(prev)-1]) || !isCJK(next[0]) {
+				b.WriteString(" ")
+			}
+		}
+		b.WriteString(word)
+	}
+	return b.String()
+}
+
+// cjkIntervals are the CJK_INTERVALS of Electrum's mnemonic.py, in the same order.
+var cjkIntervals = []struct {
+	min, max rune
+	name     string
+}{
+	{0x4E00, 0x9FFF, "CJK Unified Ideographs"},
+	{0x3400, 0x4DBF, "CJK Unified Ideographs Extension A"},
+	{0x20000, 0x2A6DF, "CJK Unified Ideographs Extension B"},
+	{0x2A700, 0x2B73F, "CJK Unified Ideographs Extension C"},
+	{0x2B740, 0x2B81F, "CJK Unified Ideographs Extension D"},
+	{0xF900, 0xFAFF, "CJK Compatibility Ideographs"},
+	{0x2F800, 0x2FA1D, "CJK Compatibility Ideographs Supplement"},
+	{0x3190, 0x319F, "Kanbun"},
+	{0x2E80, 0x2EFF, "CJK Radicals Supplement"},
+	{0x2F00, 0x2FDF, "CJK Radicals"},
+	{0x31C0, 0x31EF, "CJK Strokes"},
+	{0x2FF0, 0x2FFF, "Ideographic Description Characters"},
+	{0xE0100, 0xE01EF, "Variation Selectors Supplement"},
+	{0x3100, 0x312F, "Bopomofo"},
+	{0x31A0, 0x31BF, "Bopomofo Extended"},
+	{0xFF00, 0xFFEF, "Halfwidth and Fullwidth Forms"},
+	{0x3040, 0x309F, "Hiragana"},
+	{0x30A0, 0x30FF, "Katakana"},
+	{0x31F0, 0x31FF, "Katakana Phonetic Extensions"},
+	{0x1B000, 0x1B0FF, "Kana Supplement"},
+	{0xAC00, 0xD7AF, "Hangul Syllables"},
+	{0x1100, 0x11FF, "Hangul Jamo"},
+	{0xA960, 0xA97F, "Hangul Jamo Extended A"},
+	{0xD7B0, 0xD7FF, "Hangul Jamo Extended B"},
+	{0x3130, 0x318F, "Hangul Compatibility Jamo"},
+	{0xA4D0, 0xA4FF, "Lisu"},
+	{0x16F00, 0x16F9F, "Miao"},
+	{0xA000, 0xA48F, "Yi Syllables"},
+	{0xA490, 0xA4CF, "Yi Radicals"},
+}
+
+// isCJK reports whether r is in one of cjkIntervals, as is_CJK of Electrum does.
+func isCJK(r rune) bool {
+	for _, interval := range cjkIntervals {
+		if r >= interval.min && r <= interval.max {
+			return true
+		}
+	}
+	return false
+}
+
+// seedVersion returns the hex encoded HMAC-SHA512("Seed version", normalized mnemonic).
+func seedVersion(mnemonic string) string {
+	h := hmac.New(sha512.New, []byte("Seed version"))
+	h.Write([]byte(NormalizeText(mnemonic)))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// encodeMnemonic encodes entropy as words in base 2048, least significant word first.
+func encodeMnemonic(entropy *big.Int) string {
+	i := new(big.Int).Set(entropy)
+	n := big.NewInt(int64(len(wordlists.English)))
+	x := new(big.Int)
+	var words []string
+	for i.Sign() > 0 {
+		i.DivMod(i, n, x)
+		words = append(words, wordlists.English[x.Int64()])
+	}
+	return strings.Join(words, " ")
+}
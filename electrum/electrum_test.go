@@ -0,0 +1,173 @@
+package electrum
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gofika/bip39"
+	"golang.org/x/text/unicode/norm"
+)
+
+// https://github.com/spesmilo/electrum/blob/master/tests/test_mnemonic.py
+func TestVectors(t *testing.T) {
+	tests := []struct {
+		mnemonic   string
+		passphrase string
+		seedType   SeedType
+		seed       string
+	}{
+		{
+			mnemonic: "wild father tree among universe such mobile favorite target dynamic credit identify",
+			seedType: Segwit,
+			seed:     "aac2a6302e48577ab4b46f23dbae0774e2e62c796f797d0a1b5faeb528301e3064342dafb79069e7c4c6b8c38ae11d7a973bec0d4f70626f8cc5184a8d0b0756",
+		},
+		{
+			mnemonic:   "wild father tree among universe such mobile favorite target dynamic credit identify",
+			passphrase: "Did you ever hear the tragedy of Darth Plagueis the Wise?",
+			seedType:   Segwit,
+			seed:       "4aa29f2aeb0127efb55138ab9e7be83b36750358751906f86c662b21a1ea1370f949e6d1a12fa56d3d93cadda93038c76ac8118597364e46f5156fde6183c82f",
+		},
+		{
+			mnemonic: "なのか ひろい しなん まなぶ つぶす さがす おしゃれ かわく おいかける けさき かいとう さたん",
+			seedType: Standard,
+			seed:     "d3eaf0e44ddae3a5769cb08a26918e8b308258bcb057bb704c6f69713245c0b35cb92c03df9c9ece5eff826091b4e74041e010b701d44d610976ce8bfb66a8ad",
+		},
+		{
+			mnemonic: "眼 悲 叛 改 节 跃 衡 响 疆 股 遂 冬",
+			seedType: Segwit,
+			seed:     "0b9077db7b5a50dbb6f61821e2d35e255068a5847e221138048a20e12d80b673ce306b6fe7ac174ebc6751e11b7037be6ee9f17db8040bb44f8466d519ce2abf",
+		},
+		{
+			mnemonic:   "眼 悲 叛 改 节 跃 衡 响 疆 股 遂 冬",
+			passphrase: "给我一些测试向量谷歌",
+			seedType:   Segwit,
+			seed:       "6c03dd0615cf59963620c0af6840b52e867468cc64f20a1f4c8155705738e87b8edb0fc8a6cee4085776cb3a629ff88bb1a38f37085efdbf11ce9ec5a7fa5f71",
+		},
+		{
+			mnemonic: "almíbar tibio superar vencer hacha peatón príncipe matar consejo polen vehículo odisea",
+			seedType: Standard,
+			seed:     "18bffd573a960cc775bbd80ed60b7dc00bc8796a186edebe7fc7cf1f316da0fe937852a969c5c79ded8255cdf54409537a16339fbe33fb9161af793ea47faa7a",
+		},
+		{
+			mnemonic: "equipo fiar auge langosta hacha calor trance cubrir carro pulmón oro áspero",
+			seedType: Segwit,
+			seed:     "001ebce6bfde5851f28a0d44aae5ae0c762b600daf3b33fc8fc630aee0d207646b6f98b18e17dfe3be0a5efe2753c7cdad95860adbbb62cecad4dedb88e02a64",
+		},
+		// Accents are removed by the normalization, the seed is the same without them.
+		{
+			mnemonic: "equipo fiar auge langosta hacha calor trance cubrir carro pulmon oro aspero",
+			seedType: Segwit,
+			seed:     "001ebce6bfde5851f28a0d44aae5ae0c762b600daf3b33fc8fc630aee0d207646b6f98b18e17dfe3be0a5efe2753c7cdad95860adbbb62cecad4dedb88e02a64",
+		},
+		// Any text derives a seed, even if it is not an Electrum seed.
+		{
+			mnemonic:   "foobar",
+			passphrase: "none",
+			seed:       "741b72fd15effece6bfe5a26a52184f66811bd2be363190e07a42cca442b1a5bb22b3ad0eb338197287e6d314866c7fba863ac65d3f156087a5052ebc7157fce",
+		},
+	}
+	for _, test := range tests {
+		seedType, ok := DetectSeedType(test.mnemonic)
+		if ok != (test.seedType != 0) || seedType != test.seedType {
+			t.Fatalf("%s: invalid seed type %s", test.mnemonic, seedType)
+		}
+		seed := NewSeed(test.mnemonic, WithPassphrase(test.passphrase))
+		if hex.EncodeToString(seed) != test.seed {
+			t.Fatalf("%s: seed mismatch", test.mnemonic)
+		}
+	}
+}
+
+// https://github.com/spesmilo/electrum/blob/master/tests/test_mnemonic.py
+func TestSeedTypes(t *testing.T) {
+	tests := []struct {
+		mnemonic string
+		seedType SeedType
+	}{
+		// Electrum v1 seeds are not detected.
+		{"cell dumb heartbeat north boom tease ship baby bright kingdom rare squeeze", 0},
+		{"hurry idiot prefer sunset mention mist jaw inhale impossible kingdom rare squeeze", 0},
+		{"cram swing cover prefer miss modify ritual silly deliver chunk behind inform able", Standard},
+		{"cram swing cover prefer miss modify ritual silly deliver chunk behind inform", 0},
+		{"ostrich security deer aunt climb inner alpha arm mutual marble solid task", Standard},
+		{"OSTRICH SECURITY DEER AUNT CLIMB INNER ALPHA ARM MUTUAL MARBLE SOLID TASK", Standard},
+		{"   oStRiCh sEcUrItY DeEr aUnT ClImB       InNeR AlPhA ArM MuTuAl mArBlE   SoLiD TaSk  ", Standard},
+		{"x8", Standard},
+		{"science dawn member doll dutch real can brick knife deny drive list", TwoFactor},
+		{"science dawn member doll dutch real ca brick knife deny drive list", 0},
+		{" sCience dawn   member doll Dutch rEAl can brick knife deny drive  lisT", TwoFactor},
+		{"frost pig brisk excite novel report camera enlist axis nation novel desert", Segwit},
+		{"  fRoSt pig brisk excIte novel rePort CamEra enlist axis nation nOVeL dEsert ", Segwit},
+		{"9dk", Segwit},
+		{"kiss live scene rude gate step hip quarter bunker oxygen motor glove", TwoFactor},
+	}
+	for _, test := range tests {
+		seedType, ok := DetectSeedType(test.mnemonic)
+		if ok != (test.seedType != 0) || seedType != test.seedType {
+			t.Fatalf("%q: invalid seed type %s, expected %s", test.mnemonic, seedType, test.seedType)
+		}
+	}
+}
+
+func TestGenerateMnemonic(t *testing.T) {
+	for _, seedType := range seedTypes {
+		mnemonic, err := GenerateMnemonic(seedType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		detected, ok := DetectSeedType(mnemonic)
+		if !ok || detected != seedType {
+			t.Fatalf("invalid seed type %s, expected %s", detected, seedType)
+		}
+		if !IsSeedType(mnemonic, seedType) {
+			t.Fatal("invalid seed type")
+		}
+		// Electrum seeds are never valid BIP39 mnemonics.
+		if bip39.IsMnemonicValid(mnemonic) {
+			t.Fatal("unexpected valid BIP39 mnemonic")
+		}
+		// Normalization makes case and whitespace irrelevant.
+		if !IsSeedType("  "+strings.ToUpper(mnemonic)+"\n", seedType) {
+			t.Fatal("invalid normalized seed type")
+		}
+	}
+
+	mnemonic, err := GenerateMnemonic(Standard, WithEntropyBits(256))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if words := strings.Fields(mnemonic); len(words) != 24 {
+		t.Fatalf("invalid word count %d", len(words))
+	}
+	if _, err := GenerateMnemonic(SeedType(0)); !errors.Is(err, ErrInvalidSeedType) {
+		t.Fatal("expected ErrInvalidSeedType")
+	}
+	if _, err := GenerateMnemonic(Standard, WithEntropyBits(128)); !errors.Is(err, ErrInvalidEntropyBits) {
+		t.Fatal("expected ErrInvalidEntropyBits")
+	}
+}
+
+func TestDetectSeedTypeBIP39(t *testing.T) {
+	// A BIP39 mnemonic is normally not an Electrum seed.
+	if _, ok := DetectSeedType("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"); ok {
+		t.Fatal("unexpected Electrum seed")
+	}
+}
+
+func TestNormalizeText(t *testing.T) {
+	tests := map[string]string{
+		"  Wild   FATHER\ttree ": "wild father tree",
+		"Ábaco  ÁBACO":           "abaco abaco",
+		"十人 十色 ガバ":               "十人十色カハ",
+		"十人 abc 十色":              "十人 abc 十色",
+		// The long vowel mark is not of the Katakana script, but it is in the Katakana interval of Electrum.
+		"メー ー": "メーー",
+	}
+	for text, expected := range tests {
+		if normalized := NormalizeText(text); normalized != norm.NFKD.String(expected) {
+			t.Fatalf("%q: got %q", text, normalized)
+		}
+	}
+}
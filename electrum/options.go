@@ -0,0 +1,45 @@
+package electrum
+
+import "io"
+
+// GenerateMnemonicOptions options for GenerateMnemonic function
+type GenerateMnemonicOptions struct {
+	// entropyBits is the minimum bits of the entropy.
+	entropyBits int
+	// random is the source of the entropy.
+	random io.Reader
+}
+
+// GenerateMnemonicOption a function that modifies GenerateMnemonicOptions
+type GenerateMnemonicOption func(*GenerateMnemonicOptions)
+
+// WithEntropyBits sets the minimum bits of the entropy.
+// The entropy is rounded up to a multiple of 11 bits, one word each.
+func WithEntropyBits(entropyBits int) func(*GenerateMnemonicOptions) {
+	return func(options *GenerateMnemonicOptions) {
+		options.entropyBits = entropyBits
+	}
+}
+
+// WithRandom sets the source of the entropy.
+func WithRandom(random io.Reader) func(*GenerateMnemonicOptions) {
+	return func(options *GenerateMnemonicOptions) {
+		options.random = random
+	}
+}
+
+// NewSeedOptions options for NewSeed function
+type NewSeedOptions struct {
+	// passphrase is an optional passphrase used to generate the seed.
+	passphrase string
+}
+
+// NewSeedOption a function that modifies NewSeedOptions
+type NewSeedOption func(*NewSeedOptions)
+
+// WithPassphrase sets the passphrase used to generate the seed.
+func WithPassphrase(passphrase string) func(*NewSeedOptions) {
+	return func(options *NewSeedOptions) {
+		options.passphrase = passphrase
+	}
+}
@@ -0,0 +1,102 @@
+package bip39
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// MnemonicError reports why a mnemonic is invalid in a language, as returned by EntropyFromMnemonic.
+// It matches Err with errors.Is, and the PrefixErrors of the words with errors.As when WithPrefixes() option is used.
+//
+// Example:
+//
+//	var mnemonicErr *bip39.MnemonicError
+//	if errors.As(err, &mnemonicErr) {
+//		for _, word := range mnemonicErr.Words {
+//			fmt.Println(word.Position, word.Word) // highlight the word
+//		}
+//	}
+type MnemonicError struct {
+	// Err is ErrInvalidNumberWords, ErrInvalidMnemonic or ErrChecksumIncorrect.
+	Err error
+	// Language is the language the mnemonic was checked in.
+	Language Language
+	// WordCount is the number of words of the mnemonic.
+	WordCount int
+	// ExpectedWordCounts are the valid numbers of words: 12, 15, 18, 21 and 24.
+	ExpectedWordCounts []int
+	// Words are the invalid words, in the order of the mnemonic. It is empty unless Err is ErrInvalidMnemonic.
+	Words []WordError
+	// prefixErrs reports the words that could not be expanded, with WithPrefixes() option.
+	prefixErrs PrefixErrors
+}
+
+// WordError is an invalid word of a mnemonic.
+type WordError struct {
+	// Position is the index of the word in the mnemonic, starting at 0.
+	Position int
+	// Word is the word as found in the mnemonic, in NFKD form.
+	Word string
+}
+
+func (e *MnemonicError) Error() string {
+	switch {
+	case e.Err == ErrInvalidNumberWords:
+		counts := make([]string, len(e.ExpectedWordCounts))
+		for i, count := range e.ExpectedWordCounts {
+			counts[i] = strconv.Itoa(count)
+		}
+		return fmt.Sprintf("%s: %d words, expected %s", e.Err, e.WordCount, strings.Join(counts, ", "))
+	case e.prefixErrs != nil:
+		return fmt.Sprintf("%s in %s: %s", e.Err, e.Language, e.prefixErrs)
+	case len(e.Words) > 0:
+		msgs := make([]string, len(e.Words))
+		for i, word := range e.Words {
+			msgs[i] = fmt.Sprintf("unknown word %q at word %d", word.Word, word.Position+1)
+		}
+		return fmt.Sprintf("%s in %s: %s", e.Err, e.Language, strings.Join(msgs, "; "))
+	}
+	return fmt.Sprintf("%s in %s", e.Err, e.Language)
+}
+
+// Unwrap returns Err, and the PrefixErrors of the words if any, for use with errors.Is and errors.As.
+func (e *MnemonicError) Unwrap() []error {
+	if e.prefixErrs != nil {
+		return []error{e.Err, e.prefixErrs}
+	}
+	return []error{e.Err}
+}
+
+// newMnemonicError returns the MnemonicError of err, as returned by checkWords or expandPrefixes
+// for the NFKD words of a mnemonic. The invalid words are looked up again to report all of them.
+func (m *Mnemonic) newMnemonicError(err error, words []string) *MnemonicError {
+	e := m.mnemonicError(err, len(words))
+	if errors.As(err, &e.prefixErrs) {
+		e.Err = ErrInvalidMnemonic
+		for _, prefixErr := range e.prefixErrs {
+			e.Words = append(e.Words, WordError{Position: prefixErr.Position, Word: prefixErr.Prefix})
+		}
+		return e
+	}
+	if err == ErrInvalidMnemonic {
+		for i, word := range words {
+			if _, ok := m.wordMap[word]; !ok {
+				e.Words = append(e.Words, WordError{Position: i, Word: word})
+			}
+		}
+	}
+	return e
+}
+
+// mnemonicError returns the MnemonicError of err for a mnemonic of wordCount words, without invalid words.
+func (m *Mnemonic) mnemonicError(err error, wordCount int) *MnemonicError {
+	return &MnemonicError{
+		Err:                err,
+		Language:           m.language,
+		WordCount:          wordCount,
+		ExpectedWordCounts: slices.Clone(validWordsSizes),
+	}
+}
@@ -0,0 +1,68 @@
+package bip39
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestMnemonicError(t *testing.T) {
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mnemonicErr *MnemonicError
+
+	_, err = m.EntropyFromMnemonic("legal winner thank yaer wave sausage worth useful legal wineer thank yellow")
+	if !errors.Is(err, ErrInvalidMnemonic) || !errors.As(err, &mnemonicErr) {
+		t.Fatal("invalid error", err)
+	}
+	expected := []WordError{{Position: 3, Word: "yaer"}, {Position: 9, Word: "wineer"}}
+	if !slices.Equal(mnemonicErr.Words, expected) || mnemonicErr.Language != English || mnemonicErr.WordCount != 12 {
+		t.Fatalf("invalid error %+v", mnemonicErr)
+	}
+	if err.Error() != `invalid mnemonic in english: unknown word "yaer" at word 4; unknown word "wineer" at word 10` {
+		t.Fatal("invalid message", err)
+	}
+
+	_, err = m.EntropyFromMnemonic("legal winner thank year wave sausage worth useful legal winner thank")
+	if !errors.Is(err, ErrInvalidNumberWords) || errors.Is(err, ErrInvalidMnemonic) || !errors.As(err, &mnemonicErr) {
+		t.Fatal("invalid error", err)
+	}
+	if mnemonicErr.WordCount != 11 || !slices.Equal(mnemonicErr.ExpectedWordCounts, []int{12, 15, 18, 21, 24}) || len(mnemonicErr.Words) != 0 {
+		t.Fatalf("invalid error %+v", mnemonicErr)
+	}
+	if err.Error() != "invalid number of words: 11 words, expected 12, 15, 18, 21, 24" {
+		t.Fatal("invalid message", err)
+	}
+
+	_, err = m.EntropyFromMnemonic("legal winner thank year wave sausage worth useful legal winner thank thank")
+	if !errors.Is(err, ErrChecksumIncorrect) || !errors.As(err, &mnemonicErr) || len(mnemonicErr.Words) != 0 {
+		t.Fatal("invalid error", err)
+	}
+
+	// The language tried is reported.
+	m, err = NewMnemonic(WithLanguage(French))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = m.EntropyFromMnemonic("abandon amateur angle animal aspect badge bicycle bonus brave canal capable abandon")
+	if !errors.As(err, &mnemonicErr) || mnemonicErr.Language != French || !errors.Is(err, ErrChecksumIncorrect) {
+		t.Fatal("invalid error", err)
+	}
+
+	// Prefix errors are reported as invalid words too.
+	m, err = NewMnemonic(WithPrefixes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = m.EntropyFromMnemonic("lega ab than year wave saus wort usef lega xyzw than yell")
+	var prefixErrs PrefixErrors
+	if !errors.As(err, &mnemonicErr) || !errors.As(err, &prefixErrs) || !errors.Is(err, ErrAmbiguousPrefix) {
+		t.Fatal("invalid error", err)
+	}
+	expected = []WordError{{Position: 1, Word: "ab"}, {Position: 9, Word: "xyzw"}}
+	if !slices.Equal(mnemonicErr.Words, expected) || len(prefixErrs) != 2 {
+		t.Fatalf("invalid error %+v", mnemonicErr)
+	}
+}
@@ -0,0 +1,88 @@
+package bip39
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+)
+
+// FinalWord is a word that completes a mnemonic with a valid checksum.
+//
+// The index of the last word holds the last bits of the entropy followed by the checksum,
+// which is the first bits of the SHA256 hash of the entropy: 4 bits for 12 words, up to 8 bits for 24 words.
+type FinalWord struct {
+	// Word is the word of the wordlist, in NFKD form.
+	Word string
+	// Index is the position of Word in the wordlist.
+	Index int
+	// Entropy is the entropy of the complete mnemonic.
+	Entropy []byte
+	// EntropyBits are the leading bits of Index, the last bits of Entropy, such as "0110101" for 12 words.
+	EntropyBits string
+	// ChecksumBits are the trailing bits of Index, the first bits of SHA256(Entropy), such as "1011" for 12 words.
+	ChecksumBits string
+}
+
+// Explain explains how the final word is computed, so that it can be checked by hand or with another tool.
+func (w FinalWord) Explain() string {
+	hash := sha256.Sum256(w.Entropy)
+	wordsCount := (len(w.Entropy)*8 + len(w.ChecksumBits)) / bitsPerWord
+	var b strings.Builder
+	fmt.Fprintf(&b, "entropy: %d bits, the %d bits of the first %d words followed by %s\n", len(w.Entropy)*8, (wordsCount-1)*bitsPerWord, wordsCount-1, w.EntropyBits)
+	fmt.Fprintf(&b, "entropy (hex): %x\n", w.Entropy)
+	fmt.Fprintf(&b, "SHA256(entropy): %x\n", hash)
+	fmt.Fprintf(&b, "checksum: the first %d bits of SHA256(entropy) = %s\n", len(w.ChecksumBits), w.ChecksumBits)
+	fmt.Fprintf(&b, "final word: %s followed by %s = %d = %q", w.EntropyBits, w.ChecksumBits, w.Index, w.Word)
+	return b.String()
+}
+
+// FinalWords returns every word that completes a mnemonic missing its last word with a valid checksum,
+// in the order of the wordlist, such as for the 11 or 23 words picked with dice.
+//
+// The mnemonic must have 11, 14, 17, 20 or 23 words. As the last word holds 11 bits of which the checksum
+// takes one bit for every 3 words, there are 128 final words for 12 words mnemonics and 8 for 24 words.
+// With WithPrefixes() option, the words may be unique prefixes.
+func (m *Mnemonic) FinalWords(mnemonic string) ([]FinalWord, error) {
+	words, _ := SplitMnemonic(mnemonic)
+	wordsCount := len(words) + 1
+	if !isValidWordsSize(wordsCount) {
+		return nil, ErrInvalidNumberWords
+	}
+	if m.allowPrefixes {
+		var err error
+		words, err = expandPrefixes(m.wordMap, m.index, words)
+		if err != nil {
+			return nil, err
+		}
+	}
+	indexes := make([]int, wordsCount)
+	for i, word := range words {
+		index, ok := m.wordMap[word]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown word %q at word %d", ErrInvalidMnemonic, word, i+1)
+		}
+		indexes[i] = index
+	}
+
+	checksumBits := wordsCount / 3
+	entropyBits := bitsPerWord - checksumBits
+	entropySize := checksumBits * 4
+	finalWords := make([]FinalWord, 0, 1<<entropyBits)
+	var buf [maxEntropyWithChecksumSize]byte
+	for bits := range 1 << entropyBits {
+		indexes[wordsCount-1] = bits << checksumBits
+		packIndexes(indexes, buf[:])
+		entropy := append([]byte(nil), buf[:entropySize]...)
+		hash := sha256.Sum256(entropy)
+		checksum := int(hash[0] >> (8 - checksumBits))
+		index := bits<<checksumBits | checksum
+		finalWords = append(finalWords, FinalWord{
+			Word:         m.index.wordlist[index],
+			Index:        index,
+			Entropy:      entropy,
+			EntropyBits:  fmt.Sprintf("%0*b", entropyBits, bits),
+			ChecksumBits: fmt.Sprintf("%0*b", checksumBits, checksum),
+		})
+	}
+	return finalWords, nil
+}
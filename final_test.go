@@ -0,0 +1,80 @@
+package bip39
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFinalWords(t *testing.T) {
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		words      int
+		candidates int
+	}{
+		{12, 128},
+		{15, 64},
+		{18, 32},
+		{21, 16},
+		{24, 8},
+	}
+	for _, test := range tests {
+		finalWords, err := m.FinalWords(strings.Repeat("zoo ", test.words-1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(finalWords) != test.candidates {
+			t.Fatalf("%d words: invalid number of final words %d", test.words, len(finalWords))
+		}
+		for i, finalWord := range finalWords {
+			mnemonic := strings.Repeat("zoo ", test.words-1) + finalWord.Word
+			entropy, err := m.EntropyFromMnemonic(mnemonic)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(entropy) != string(finalWord.Entropy) || m.wordList[finalWord.Index] != finalWord.Word {
+				t.Fatal("invalid final word", finalWord)
+			}
+			if len(finalWord.EntropyBits)+len(finalWord.ChecksumBits) != 11 || len(finalWord.ChecksumBits) != test.words/3 {
+				t.Fatal("invalid final word bits", finalWord)
+			}
+			if i > 0 && finalWords[i-1].Index >= finalWord.Index {
+				t.Fatal("final words out of order")
+			}
+		}
+	}
+}
+
+func TestFinalWordExplain(t *testing.T) {
+	m, err := NewMnemonic(WithPrefixes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	finalWords, err := m.FinalWords("lega winn than year wave saus wort usef lega winn than")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// yellow is the word 2040, 1111111 followed by the checksum 1000.
+	finalWord := finalWords[127]
+	if finalWord.Word != "yellow" || finalWord.Index != 2040 || finalWord.EntropyBits != "1111111" || finalWord.ChecksumBits != "1000" {
+		t.Fatal("invalid final word", finalWord)
+	}
+	const explanation = `entropy: 128 bits, the 121 bits of the first 11 words followed by 1111111
+entropy (hex): 7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f
+SHA256(entropy): 87dcde7fa6df23e15fa7ba9b2a1f31408eac832f4e615ea815ae92024e3d818b
+checksum: the first 4 bits of SHA256(entropy) = 1000
+final word: 1111111 followed by 1000 = 2040 = "yellow"`
+	if finalWord.Explain() != explanation {
+		t.Fatal("invalid explanation", finalWord.Explain())
+	}
+
+	if _, err = m.FinalWords("lega winn than"); err != ErrInvalidNumberWords {
+		t.Fatal("invalid error", err)
+	}
+	if _, err = m.FinalWords("lega winn than year wave saus wort usef lega winn xyzw"); !errors.Is(err, ErrUnknownPrefix) {
+		t.Fatal("invalid error", err)
+	}
+}
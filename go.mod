@@ -2,4 +2,10 @@ module github.com/gofika/bip39
 
 go 1.25.0
 
-require golang.org/x/crypto v0.53.0
+require (
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.4.1
+	golang.org/x/crypto v0.53.0
+	golang.org/x/sys v0.46.0
+	golang.org/x/term v0.44.0
+	golang.org/x/text v0.38.0
+)
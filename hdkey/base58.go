@@ -0,0 +1,95 @@
+package hdkey
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var (
+	errInvalidBase58 = errors.New("invalid base58 character")
+
+	bigRadix = big.NewInt(58)
+	bigZero  = big.NewInt(0)
+
+	// base58Indexes maps each base58 character to its value, -1 for characters outside the alphabet.
+	base58Indexes = func() [256]int {
+		var indexes [256]int
+		for i := range indexes {
+			indexes[i] = -1
+		}
+		for i, c := range base58Alphabet {
+			indexes[c] = i
+		}
+		return indexes
+	}()
+)
+
+// base58CheckEncode appends the first 4 bytes of the double SHA256 of data to data and encodes it as base58.
+func base58CheckEncode(data []byte) string {
+	checksum := doubleSHA256(data)
+	return base58Encode(append(data[:len(data):len(data)], checksum[:4]...))
+}
+
+// base58CheckDecode decodes s and verifies the trailing 4 bytes checksum.
+func base58CheckDecode(s string) ([]byte, error) {
+	decoded, err := base58Decode(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) < 4 {
+		return nil, ErrInvalidChecksum
+	}
+	data, checksum := decoded[:len(decoded)-4], decoded[len(decoded)-4:]
+	expected := doubleSHA256(data)
+	if [4]byte(checksum) != [4]byte(expected[:4]) {
+		return nil, ErrInvalidChecksum
+	}
+	return data, nil
+}
+
+func base58Encode(data []byte) string {
+	x := new(big.Int).SetBytes(data)
+	mod := new(big.Int)
+	var encoded []byte
+	for x.Cmp(bigZero) > 0 {
+		x.DivMod(x, bigRadix, mod)
+		encoded = append(encoded, base58Alphabet[mod.Int64()])
+	}
+	// Leading zero bytes are encoded as leading '1' characters.
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		encoded = append(encoded, base58Alphabet[0])
+	}
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+	return string(encoded)
+}
+
+func base58Decode(s string) ([]byte, error) {
+	x := new(big.Int)
+	for i := 0; i < len(s); i++ {
+		index := base58Indexes[s[i]]
+		if index < 0 {
+			return nil, errInvalidBase58
+		}
+		x.Mul(x, bigRadix)
+		x.Add(x, big.NewInt(int64(index)))
+	}
+	var zeros int
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+	decoded := x.Bytes()
+	return append(make([]byte, zeros, zeros+len(decoded)), decoded...), nil
+}
+
+func doubleSHA256(data []byte) [32]byte {
+	first := sha256.Sum256(data)
+	return sha256.Sum256(first[:])
+}
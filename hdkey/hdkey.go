@@ -0,0 +1,360 @@
+// Package hdkey implements BIP32 hierarchical deterministic keys on the secp256k1 curve.
+//
+// A master key is created from the seed returned by bip39.NewSeed:
+//
+//	seed := bip39.NewSeed(mnemonic, bip39.WithPassphrase(passphrase))
+//	master, err := hdkey.NewMaster(seed)
+//	if err != nil {
+//		panic(err)
+//	}
+//	account, err := master.Derive(hdkey.HardenedKeyStart + 0)
+package hdkey
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"golang.org/x/crypto/ripemd160"
+)
+
+const (
+	// HardenedKeyStart is the index of the first hardened child key.
+	HardenedKeyStart uint32 = 0x80000000
+
+	// MinSeedBytes is the minimum number of bytes of a seed. (128 bits)
+	MinSeedBytes = 16
+	// MaxSeedBytes is the maximum number of bytes of a seed. (512 bits)
+	MaxSeedBytes = 64
+
+	// serializedKeyLen is the length of a serialized extended key without the checksum.
+	// version(4) || depth(1) || parent fingerprint(4) || child number(4) || chain code(32) || key data(33)
+	serializedKeyLen = 4 + 1 + 4 + 4 + 32 + 33
+)
+
+var (
+	ErrInvalidSeedLength    = errors.New("invalid seed length")
+	ErrUnusableSeed         = errors.New("unusable seed")
+	ErrInvalidChild         = errors.New("invalid child")
+	ErrDeriveHardenedPublic = errors.New("cannot derive a hardened key from a public key")
+	ErrNotPrivate           = errors.New("not a private key")
+	ErrMaxDepth             = errors.New("maximum depth exceeded")
+	ErrInvalidKeyLength     = errors.New("invalid extended key length")
+	ErrInvalidChecksum      = errors.New("checksum incorrect")
+	ErrUnknownVersion       = errors.New("unknown extended key version")
+	ErrInvalidPrivateKey    = errors.New("invalid private key")
+	ErrInvalidPublicKey     = errors.New("invalid public key")
+	ErrInvalidParent        = errors.New("invalid parent fingerprint or child number")
+)
+
+var (
+	// masterKey is the HMAC key used to create a master key from a seed.
+	masterKey = []byte("Bitcoin seed")
+
+	// MainnetVersions are the version bytes of mainnet extended keys. (xprv/xpub)
+	MainnetVersions = Versions{
+		Private: [4]byte{0x04, 0x88, 0xad, 0xe4},
+		Public:  [4]byte{0x04, 0x88, 0xb2, 0x1e},
+	}
+	// TestnetVersions are the version bytes of testnet extended keys. (tprv/tpub)
+	TestnetVersions = Versions{
+		Private: [4]byte{0x04, 0x35, 0x83, 0x94},
+		Public:  [4]byte{0x04, 0x35, 0x87, 0xcf},
+	}
+)
+
+// Versions is a pair of version bytes used to serialize private and public extended keys.
+type Versions struct {
+	Private [4]byte
+	Public  [4]byte
+}
+
+// ExtendedKey is a BIP32 extended private or public key.
+type ExtendedKey struct {
+	versions          Versions
+	depth             uint8
+	parentFingerprint uint32
+	childNumber       uint32
+	chainCode         []byte
+	// key is the 32 bytes private key, or the 33 bytes compressed public key if the key is neutered.
+	key       []byte
+	isPrivate bool
+}
+
+// NewMaster creates a new master extended private key from a seed.
+//
+// The seed must be between 16 and 64 bytes, such as the 64 bytes seed returned by bip39.NewSeed.
+// The default versions are MainnetVersions.
+// If you want to set the versions, use WithVersions() option.
+func NewMaster(seed []byte, opts ...ExtendedKeyOption) (*ExtendedKey, error) {
+	options := &ExtendedKeyOptions{
+		versions: MainnetVersions,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if len(seed) < MinSeedBytes || len(seed) > MaxSeedBytes {
+		return nil, ErrInvalidSeedLength
+	}
+
+	h := hmac.New(sha512.New, masterKey)
+	h.Write(seed)
+	sum := h.Sum(nil)
+	key, chainCode := sum[:32], sum[32:]
+
+	var k secp256k1.ModNScalar
+	if overflow := k.SetByteSlice(key); overflow || k.IsZero() {
+		return nil, ErrUnusableSeed
+	}
+
+	return &ExtendedKey{
+		versions:  options.versions,
+		chainCode: chainCode,
+		key:       key,
+		isPrivate: true,
+	}, nil
+}
+
+// Derive returns the child extended key at the given index.
+//
+// Indexes at or above HardenedKeyStart derive hardened children, which requires a private key.
+// A private key derives a private child, and a public key derives a public child.
+// ErrInvalidChild is returned in the rare case (probability lower than 1 in 2^127)
+// that the index produces an invalid key, in which case the caller should proceed with the next index.
+func (k *ExtendedKey) Derive(index uint32) (*ExtendedKey, error) {
+	if k.depth == 255 {
+		return nil, ErrMaxDepth
+	}
+	isHardened := index >= HardenedKeyStart
+	if isHardened && !k.isPrivate {
+		return nil, ErrDeriveHardenedPublic
+	}
+
+	// Hardened: HMAC-SHA512(Key = chain code, Data = 0x00 || ser256(k) || ser32(i))
+	// Normal:   HMAC-SHA512(Key = chain code, Data = serP(K) || ser32(i))
+	data := make([]byte, 0, 37)
+	if isHardened {
+		data = append(data, 0x00)
+		data = append(data, k.key...)
+	} else {
+		data = append(data, k.PublicKey()...)
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	h := hmac.New(sha512.New, k.chainCode)
+	h.Write(data)
+	sum := h.Sum(nil)
+	il, chainCode := sum[:32], sum[32:]
+
+	var ilNum secp256k1.ModNScalar
+	if overflow := ilNum.SetByteSlice(il); overflow {
+		return nil, ErrInvalidChild
+	}
+
+	var childKey []byte
+	if k.isPrivate {
+		// k_i = parse256(IL) + k_par (mod n)
+		var keyNum secp256k1.ModNScalar
+		keyNum.SetByteSlice(k.key)
+		keyNum.Add(&ilNum)
+		if keyNum.IsZero() {
+			return nil, ErrInvalidChild
+		}
+		b := keyNum.Bytes()
+		childKey = b[:]
+	} else {
+		// K_i = point(parse256(IL)) + K_par
+		parent, err := secp256k1.ParsePubKey(k.key)
+		if err != nil {
+			return nil, err
+		}
+		var ilPoint, parentPoint, result secp256k1.JacobianPoint
+		secp256k1.ScalarBaseMultNonConst(&ilNum, &ilPoint)
+		parent.AsJacobian(&parentPoint)
+		secp256k1.AddNonConst(&ilPoint, &parentPoint, &result)
+		if (result.X.IsZero() && result.Y.IsZero()) || result.Z.IsZero() {
+			return nil, ErrInvalidChild
+		}
+		result.ToAffine()
+		childKey = secp256k1.NewPublicKey(&result.X, &result.Y).SerializeCompressed()
+	}
+
+	return &ExtendedKey{
+		versions:          k.versions,
+		depth:             k.depth + 1,
+		parentFingerprint: k.Fingerprint(),
+		childNumber:       index,
+		chainCode:         chainCode,
+		key:               childKey,
+		isPrivate:         k.isPrivate,
+	}, nil
+}
+
+// DerivePath derives the descendant extended key by applying Derive for each index in turn.
+func (k *ExtendedKey) DerivePath(indexes ...uint32) (*ExtendedKey, error) {
+	key := k
+	for _, index := range indexes {
+		var err error
+		key, err = key.Derive(index)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// Neuter returns the extended public key corresponding to the extended key.
+// Public-only keys can derive non-hardened children only.
+func (k *ExtendedKey) Neuter() *ExtendedKey {
+	if !k.isPrivate {
+		return k
+	}
+	return &ExtendedKey{
+		versions:          k.versions,
+		depth:             k.depth,
+		parentFingerprint: k.parentFingerprint,
+		childNumber:       k.childNumber,
+		chainCode:         k.chainCode,
+		key:               k.PublicKey(),
+	}
+}
+
+// IsPrivate reports whether the extended key is a private key.
+func (k *ExtendedKey) IsPrivate() bool {
+	return k.isPrivate
+}
+
+// Depth returns the depth of the key. The master key has depth 0.
+func (k *ExtendedKey) Depth() uint8 {
+	return k.depth
+}
+
+// ChildNumber returns the index the key was derived with. The master key has child number 0.
+func (k *ExtendedKey) ChildNumber() uint32 {
+	return k.childNumber
+}
+
+// ParentFingerprint returns the fingerprint of the parent key. The master key has parent fingerprint 0.
+func (k *ExtendedKey) ParentFingerprint() uint32 {
+	return k.parentFingerprint
+}
+
+// ChainCode returns a copy of the 32 bytes chain code.
+func (k *ExtendedKey) ChainCode() []byte {
+	return bytes.Clone(k.chainCode)
+}
+
+// PrivateKey returns a copy of the 32 bytes private key.
+// ErrNotPrivate is returned if the key is neutered.
+func (k *ExtendedKey) PrivateKey() ([]byte, error) {
+	if !k.isPrivate {
+		return nil, ErrNotPrivate
+	}
+	return bytes.Clone(k.key), nil
+}
+
+// PublicKey returns the 33 bytes compressed public key.
+func (k *ExtendedKey) PublicKey() []byte {
+	if !k.isPrivate {
+		return bytes.Clone(k.key)
+	}
+	return secp256k1.PrivKeyFromBytes(k.key).PubKey().SerializeCompressed()
+}
+
+// Fingerprint returns the first 32 bits of the key identifier, HASH160 of the public key.
+// The fingerprint of the master key is the master fingerprint used in descriptors and PSBTs.
+func (k *ExtendedKey) Fingerprint() uint32 {
+	return binary.BigEndian.Uint32(hash160(k.PublicKey())[:4])
+}
+
+// String returns the Base58Check serialization of the extended key, such as "xprv..." or "xpub...".
+func (k *ExtendedKey) String() string {
+	data := make([]byte, 0, serializedKeyLen)
+	if k.isPrivate {
+		data = append(data, k.versions.Private[:]...)
+	} else {
+		data = append(data, k.versions.Public[:]...)
+	}
+	data = append(data, k.depth)
+	data = binary.BigEndian.AppendUint32(data, k.parentFingerprint)
+	data = binary.BigEndian.AppendUint32(data, k.childNumber)
+	data = append(data, k.chainCode...)
+	if k.isPrivate {
+		data = append(data, 0x00)
+	}
+	data = append(data, k.key...)
+	return base58CheckEncode(data)
+}
+
+// ParseExtendedKey parses a Base58Check serialized extended key.
+//
+// The default versions are MainnetVersions.
+// If you want to parse keys of other versions, use WithVersions() option.
+func ParseExtendedKey(s string, opts ...ExtendedKeyOption) (*ExtendedKey, error) {
+	options := &ExtendedKeyOptions{
+		versions: MainnetVersions,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	data, err := base58CheckDecode(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != serializedKeyLen {
+		return nil, ErrInvalidKeyLength
+	}
+
+	version := [4]byte(data[:4])
+	depth := data[4]
+	parentFingerprint := binary.BigEndian.Uint32(data[5:9])
+	childNumber := binary.BigEndian.Uint32(data[9:13])
+	chainCode := bytes.Clone(data[13:45])
+	keyData := data[45:]
+
+	if depth == 0 && (parentFingerprint != 0 || childNumber != 0) {
+		return nil, ErrInvalidParent
+	}
+
+	key := &ExtendedKey{
+		versions:          options.versions,
+		depth:             depth,
+		parentFingerprint: parentFingerprint,
+		childNumber:       childNumber,
+		chainCode:         chainCode,
+	}
+	switch version {
+	case options.versions.Private:
+		if keyData[0] != 0x00 {
+			return nil, ErrInvalidPrivateKey
+		}
+		var k secp256k1.ModNScalar
+		if overflow := k.SetByteSlice(keyData[1:]); overflow || k.IsZero() {
+			return nil, ErrInvalidPrivateKey
+		}
+		key.key = bytes.Clone(keyData[1:])
+		key.isPrivate = true
+	case options.versions.Public:
+		if keyData[0] != 0x02 && keyData[0] != 0x03 {
+			return nil, ErrInvalidPublicKey
+		}
+		if _, err := secp256k1.ParsePubKey(keyData); err != nil {
+			return nil, ErrInvalidPublicKey
+		}
+		key.key = bytes.Clone(keyData)
+	default:
+		return nil, ErrUnknownVersion
+	}
+	return key, nil
+}
+
+func hash160(data []byte) []byte {
+	sha := sha256.Sum256(data)
+	h := ripemd160.New()
+	h.Write(sha[:])
+	return h.Sum(nil)
+}
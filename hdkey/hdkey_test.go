@@ -0,0 +1,298 @@
+package hdkey
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+const h = HardenedKeyStart
+
+// https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki#test-vectors
+var bip32Vectors = []struct {
+	seed   string
+	chains []struct {
+		path []uint32
+		pub  string
+		prv  string
+	}
+}{
+	// Test vector 1
+	{
+		seed: "000102030405060708090a0b0c0d0e0f",
+		chains: []struct {
+			path []uint32
+			pub  string
+			prv  string
+		}{
+			{
+				path: []uint32{},
+				pub:  "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8",
+				prv:  "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi",
+			},
+			{
+				path: []uint32{h},
+				pub:  "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw",
+				prv:  "xprv9uHRZZhk6KAJC1avXpDAp4MDc3sQKNxDiPvvkX8Br5ngLNv1TxvUxt4cV1rGL5hj6KCesnDYUhd7oWgT11eZG7XnxHrnYeSvkzY7d2bhkJ7",
+			},
+			{
+				path: []uint32{h, 1},
+				pub:  "xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ",
+				prv:  "xprv9wTYmMFdV23N2TdNG573QoEsfRrWKQgWeibmLntzniatZvR9BmLnvSxqu53Kw1UmYPxLgboyZQaXwTCg8MSY3H2EU4pWcQDnRnrVA1xe8fs",
+			},
+			{
+				path: []uint32{h, 1, h + 2},
+				pub:  "xpub6D4BDPcP2GT577Vvch3R8wDkScZWzQzMMUm3PWbmWvVJrZwQY4VUNgqFJPMM3No2dFDFGTsxxpG5uJh7n7epu4trkrX7x7DogT5Uv6fcLW5",
+				prv:  "xprv9z4pot5VBttmtdRTWfWQmoH1taj2axGVzFqSb8C9xaxKymcFzXBDptWmT7FwuEzG3ryjH4ktypQSAewRiNMjANTtpgP4mLTj34bhnZX7UiM",
+			},
+			{
+				path: []uint32{h, 1, h + 2, 2},
+				pub:  "xpub6FHa3pjLCk84BayeJxFW2SP4XRrFd1JYnxeLeU8EqN3vDfZmbqBqaGJAyiLjTAwm6ZLRQUMv1ZACTj37sR62cfN7fe5JnJ7dh8zL4fiyLHV",
+				prv:  "xprvA2JDeKCSNNZky6uBCviVfJSKyQ1mDYahRjijr5idH2WwLsEd4Hsb2Tyh8RfQMuPh7f7RtyzTtdrbdqqsunu5Mm3wDvUAKRHSC34sJ7in334",
+			},
+			{
+				path: []uint32{h, 1, h + 2, 2, 1000000000},
+				pub:  "xpub6H1LXWLaKsWFhvm6RVpEL9P4KfRZSW7abD2ttkWP3SSQvnyA8FSVqNTEcYFgJS2UaFcxupHiYkro49S8yGasTvXEYBVPamhGW6cFJodrTHy",
+				prv:  "xprvA41z7zogVVwxVSgdKUHDy1SKmdb533PjDz7J6N6mV6uS3ze1ai8FHa8kmHScGpWmj4WggLyQjgPie1rFSruoUihUZREPSL39UNdE3BBDu76",
+			},
+		},
+	},
+	// Test vector 2
+	{
+		seed: "fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542",
+		chains: []struct {
+			path []uint32
+			pub  string
+			prv  string
+		}{
+			{
+				path: []uint32{},
+				pub:  "xpub661MyMwAqRbcFW31YEwpkMuc5THy2PSt5bDMsktWQcFF8syAmRUapSCGu8ED9W6oDMSgv6Zz8idoc4a6mr8BDzTJY47LJhkJ8UB7WEGuduB",
+				prv:  "xprv9s21ZrQH143K31xYSDQpPDxsXRTUcvj2iNHm5NUtrGiGG5e2DtALGdso3pGz6ssrdK4PFmM8NSpSBHNqPqm55Qn3LqFtT2emdEXVYsCzC2U",
+			},
+			{
+				path: []uint32{0},
+				pub:  "xpub69H7F5d8KSRgmmdJg2KhpAK8SR3DjMwAdkxj3ZuxV27CprR9LgpeyGmXUbC6wb7ERfvrnKZjXoUmmDznezpbZb7ap6r1D3tgFxHmwMkQTPH",
+				prv:  "xprv9vHkqa6EV4sPZHYqZznhT2NPtPCjKuDKGY38FBWLvgaDx45zo9WQRUT3dKYnjwih2yJD9mkrocEZXo1ex8G81dwSM1fwqWpWkeS3v86pgKt",
+			},
+			{
+				path: []uint32{0, h + 2147483647},
+				pub:  "xpub6ASAVgeehLbnwdqV6UKMHVzgqAG8Gr6riv3Fxxpj8ksbH9ebxaEyBLZ85ySDhKiLDBrQSARLq1uNRts8RuJiHjaDMBU4Zn9h8LZNnBC5y4a",
+				prv:  "xprv9wSp6B7kry3Vj9m1zSnLvN3xH8RdsPP1Mh7fAaR7aRLcQMKTR2vidYEeEg2mUCTAwCd6vnxVrcjfy2kRgVsFawNzmjuHc2YmYRmagcEPdU9",
+			},
+			{
+				path: []uint32{0, h + 2147483647, 1},
+				pub:  "xpub6DF8uhdarytz3FWdA8TvFSvvAh8dP3283MY7p2V4SeE2wyWmG5mg5EwVvmdMVCQcoNJxGoWaU9DCWh89LojfZ537wTfunKau47EL2dhHKon",
+				prv:  "xprv9zFnWC6h2cLgpmSA46vutJzBcfJ8yaJGg8cX1e5StJh45BBciYTRXSd25UEPVuesF9yog62tGAQtHjXajPPdbRCHuWS6T8XA2ECKADdw4Ef",
+			},
+			{
+				path: []uint32{0, h + 2147483647, 1, h + 2147483646},
+				pub:  "xpub6ERApfZwUNrhLCkDtcHTcxd75RbzS1ed54G1LkBUHQVHQKqhMkhgbmJbZRkrgZw4koxb5JaHWkY4ALHY2grBGRjaDMzQLcgJvLJuZZvRcEL",
+				prv:  "xprvA1RpRA33e1JQ7ifknakTFpgNXPmW2YvmhqLQYMmrj4xJXXWYpDPS3xz7iAxn8L39njGVyuoseXzU6rcxFLJ8HFsTjSyQbLYnMpCqE2VbFWc",
+			},
+			{
+				path: []uint32{0, h + 2147483647, 1, h + 2147483646, 2},
+				pub:  "xpub6FnCn6nSzZAw5Tw7cgR9bi15UV96gLZhjDstkXXxvCLsUXBGXPdSnLFbdpq8p9HmGsApME5hQTZ3emM2rnY5agb9rXpVGyy3bdW6EEgAtqt",
+				prv:  "xprvA2nrNbFZABcdryreWet9Ea4LvTJcGsqrMzxHx98MMrotbir7yrKCEXw7nadnHM8Dq38EGfSh6dqA9QWTyefMLEcBYJUuekgW4BYPJcr9E7j",
+			},
+		},
+	},
+	// Test vector 3: retention of leading zeros
+	{
+		seed: "4b381541583be4423346c643850da4b320e46a87ae3d2a4e6da11eba819cd4acba45d239319ac14f863b8d5ab5a0d0c64d2e8a1e7d1457df2e5a3c51c73235be",
+		chains: []struct {
+			path []uint32
+			pub  string
+			prv  string
+		}{
+			{
+				path: []uint32{},
+				pub:  "xpub661MyMwAqRbcEZVB4dScxMAdx6d4nFc9nvyvH3v4gJL378CSRZiYmhRoP7mBy6gSPSCYk6SzXPTf3ND1cZAceL7SfJ1Z3GC8vBgp2epUt13",
+				prv:  "xprv9s21ZrQH143K25QhxbucbDDuQ4naNntJRi4KUfWT7xo4EKsHt2QJDu7KXp1A3u7Bi1j8ph3EGsZ9Xvz9dGuVrtHHs7pXeTzjuxBrCmmhgC6",
+			},
+			{
+				path: []uint32{h},
+				pub:  "xpub68NZiKmJWnxxS6aaHmn81bvJeTESw724CRDs6HbuccFQN9Ku14VQrADWgqbhhTHBaohPX4CjNLf9fq9MYo6oDaPPLPxSb7gwQN3ih19Zm4Y",
+				prv:  "xprv9uPDJpEQgRQfDcW7BkF7eTya6RPxXeJCqCJGHuCJ4GiRVLzkTXBAJMu2qaMWPrS7AANYqdq6vcBcBUdJCVVFceUvJFjaPdGZ2y9WACViL4L",
+			},
+		},
+	},
+	// Test vector 4: retention of leading zeros in hardened derivation
+	{
+		seed: "3ddd5602285899a946114506157c7997e5444528f3003f6134712147db19b678",
+		chains: []struct {
+			path []uint32
+			pub  string
+			prv  string
+		}{
+			{
+				path: []uint32{},
+				pub:  "xpub661MyMwAqRbcGczjuMoRm6dXaLDEhW1u34gKenbeYqAix21mdUKJyuyu5F1rzYGVxyL6tmgBUAEPrEz92mBXjByMRiJdba9wpnN37RLLAXa",
+				prv:  "xprv9s21ZrQH143K48vGoLGRPxgo2JNkJ3J3fqkirQC2zVdk5Dgd5w14S7fRDyHH4dWNHUgkvsvNDCkvAwcSHNAQwhwgNMgZhLtQC63zxwhQmRv",
+			},
+			{
+				path: []uint32{h},
+				pub:  "xpub69AUMk3qDBi3uW1sXgjCmVjJ2G6WQoYSnNHyzkmdCHEhSZ4tBok37xfFEqHd2AddP56Tqp4o56AePAgCjYdvpW2PU2jbUPFKsav5ut6Ch1m",
+				prv:  "xprv9vB7xEWwNp9kh1wQRfCCQMnZUEG21LpbR9NPCNN1dwhiZkjjeGRnaALmPXCX7SgjFTiCTT6bXes17boXtjq3xLpcDjzEuGLQBM5ohqkao9G",
+			},
+			{
+				path: []uint32{h, h + 1},
+				pub:  "xpub6BJA1jSqiukeaesWfxe6sNK9CCGaujFFSJLomWHprUL9DePQ4JDkM5d88n49sMGJxrhpjazuXYWdMf17C9T5XnxkopaeS7jGk1GyyVziaMt",
+				prv:  "xprv9xJocDuwtYCMNAo3Zw76WENQeAS6WGXQ55RCy7tDJ8oALr4FWkuVoHJeHVAcAqiZLE7Je3vZJHxspZdFHfnBEjHqU5hG1Jaj32dVoS6XLT1",
+			},
+		},
+	},
+}
+
+func TestVectors(t *testing.T) {
+	for _, vector := range bip32Vectors {
+		seed, err := hex.DecodeString(vector.seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		master, err := NewMaster(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, chain := range vector.chains {
+			key, err := master.DerivePath(chain.path...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if key.String() != chain.prv {
+				t.Fatalf("private key mismatch: %v %s", chain.path, key)
+			}
+			if key.Neuter().String() != chain.pub {
+				t.Fatalf("public key mismatch: %v %s", chain.path, key.Neuter())
+			}
+			if int(key.Depth()) != len(chain.path) {
+				t.Fatal("depth mismatch")
+			}
+
+			// Round trip through serialization.
+			parsed, err := ParseExtendedKey(chain.prv)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if parsed.String() != chain.prv {
+				t.Fatal("parsed private key mismatch")
+			}
+			parsed, err = ParseExtendedKey(chain.pub)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if parsed.String() != chain.pub || parsed.IsPrivate() {
+				t.Fatal("parsed public key mismatch")
+			}
+		}
+	}
+}
+
+func TestPublicDerivation(t *testing.T) {
+	// Non-hardened public derivation must match the neutered private derivation.
+	vector := bip32Vectors[1]
+	seed, err := hex.DecodeString(vector.seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	master, err := NewMaster(seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	public, err := master.Neuter().Derive(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if public.String() != vector.chains[1].pub {
+		t.Fatal("public derivation mismatch")
+	}
+
+	parent, err := ParseExtendedKey(vector.chains[3].pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = parent.Derive(h + 2147483646)
+	if !errors.Is(err, ErrDeriveHardenedPublic) {
+		t.Fatal("expected ErrDeriveHardenedPublic")
+	}
+	if _, err := parent.PrivateKey(); !errors.Is(err, ErrNotPrivate) {
+		t.Fatal("expected ErrNotPrivate")
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	seed, err := hex.DecodeString(bip32Vectors[0].seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	master, err := NewMaster(seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if master.Fingerprint() != 0x3442193e {
+		t.Fatalf("invalid master fingerprint: %08x", master.Fingerprint())
+	}
+	child, err := master.Derive(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if child.ParentFingerprint() != master.Fingerprint() || child.ChildNumber() != h {
+		t.Fatal("invalid child")
+	}
+}
+
+func TestNewMasterSeedLength(t *testing.T) {
+	if _, err := NewMaster(make([]byte, MinSeedBytes-1)); !errors.Is(err, ErrInvalidSeedLength) {
+		t.Fatal("expected ErrInvalidSeedLength")
+	}
+	if _, err := NewMaster(make([]byte, MaxSeedBytes+1)); !errors.Is(err, ErrInvalidSeedLength) {
+		t.Fatal("expected ErrInvalidSeedLength")
+	}
+}
+
+// Test vector 5: invalid extended keys
+func TestInvalidExtendedKeys(t *testing.T) {
+	tests := []struct {
+		key string
+		err error
+	}{
+		// pubkey version / prvkey mismatch
+		{"xpub661MyMwAqRbcEYS8w7XLSVeEsBXy79zSzH1J8vCdxAZningWLdN3zgtU6LBpB85b3D2yc8sfvZU521AAwdZafEz7mnzBBsz4wKY5fTtTQBm", ErrInvalidPublicKey},
+		// prvkey version / pubkey mismatch
+		{"xprv9s21ZrQH143K24Mfq5zL5MhWK9hUhhGbd45hLXo2Pq2oqzMMo63oStZzFGTQQD3dC4H2D5GBj7vWvSQaaBv5cxi9gafk7NF3pnBju6dwKvH", ErrInvalidPrivateKey},
+		// invalid pubkey prefix 04
+		{"xpub661MyMwAqRbcEYS8w7XLSVeEsBXy79zSzH1J8vCdxAZningWLdN3zgtU6Txnt3siSujt9RCVYsx4qHZGc62TG4McvMGcAUjeuwZdduYEvFn", ErrInvalidPublicKey},
+		// invalid prvkey prefix 04
+		{"xprv9s21ZrQH143K24Mfq5zL5MhWK9hUhhGbd45hLXo2Pq2oqzMMo63oStZzFGpWnsj83BHtEy5Zt8CcDr1UiRXuWCmTQLxEK9vbz5gPstX92JQ", ErrInvalidPrivateKey},
+		// invalid pubkey prefix 01
+		{"xpub661MyMwAqRbcEYS8w7XLSVeEsBXy79zSzH1J8vCdxAZningWLdN3zgtU6N8ZMMXctdiCjxTNq964yKkwrkBJJwpzZS4HS2fxvyYUA4q2Xe4", ErrInvalidPublicKey},
+		// invalid prvkey prefix 01
+		{"xprv9s21ZrQH143K24Mfq5zL5MhWK9hUhhGbd45hLXo2Pq2oqzMMo63oStZzFAzHGBP2UuGCqWLTAPLcMtD9y5gkZ6Eq3Rjuahrv17fEQ3Qen6J", ErrInvalidPrivateKey},
+		// zero depth with non-zero parent fingerprint
+		{"xprv9s2SPatNQ9Vc6GTbVMFPFo7jsaZySyzk7L8n2uqKXJen3KUmvQNTuLh3fhZMBoG3G4ZW1N2kZuHEPY53qmbZzCHshoQnNf4GvELZfqTUrcv", ErrInvalidParent},
+		// zero depth with non-zero parent fingerprint
+		{"xpub661no6RGEX3uJkY4bNnPcw4URcQTrSibUZ4NqJEw5eBkv7ovTwgiT91XX27VbEXGENhYRCf7hyEbWrR3FewATdCEebj6znwMfQkhRYHRLpJ", ErrInvalidParent},
+		// zero depth with non-zero index
+		{"xprv9s21ZrQH4r4TsiLvyLXqM9P7k1K3EYhA1kkD6xuquB5i39AU8KF42acDyL3qsDbU9NmZn6MsGSUYZEsuoePmjzsB3eFKSUEh3Gu1N3cqVUN", ErrInvalidParent},
+		// zero depth with non-zero index
+		{"xpub661MyMwAuDcm6CRQ5N4qiHKrJ39Xe1R1NyfouMKTTWcguwVcfrZJaNvhpebzGerh7gucBvzEQWRugZDuDXjNDRmXzSZe4c7mnTK97pTvGS8", ErrInvalidParent},
+		// unknown extended key version
+		{"DMwo58pR1QLEFihHiXPVykYB6fJmsTeHvyTp7hRThAtCX8CvYzgPcn8XnmdfHGMQzT7ayAmfo4z3gY5KfbrZWZ6St24UVf2Qgo6oujFktLHdHY4", ErrUnknownVersion},
+		// unknown extended key version
+		{"DMwo58pR1QLEFihHiXPVykYB6fJmsTeHvyTp7hRThAtCX8CvYzgPcn8XnmdfHPmHJiEDXkTiJTVV9rHEBUem2mwVbbNfvT2MTcAqj3nesx8uBf9", ErrUnknownVersion},
+		// private key 0 not in 1..n-1
+		{"xprv9s21ZrQH143K24Mfq5zL5MhWK9hUhhGbd45hLXo2Pq2oqzMMo63oStZzF93Y5wvzdUayhgkkFoicQZcP3y52uPPxFnfoLZB21Teqt1VvEHx", ErrInvalidPrivateKey},
+		// private key n not in 1..n-1
+		{"xprv9s21ZrQH143K24Mfq5zL5MhWK9hUhhGbd45hLXo2Pq2oqzMMo63oStZzFAzHGBP2UuGCqWLTAPLcMtD5SDKr24z3aiUvKr9bJpdrcLg1y3G", ErrInvalidPrivateKey},
+		// invalid pubkey 020000000000000000000000000000000000000000000000000000000000000007
+		{"xpub661MyMwAqRbcEYS8w7XLSVeEsBXy79zSzH1J8vCdxAZningWLdN3zgtU6Q5JXayek4PRsn35jii4veMimro1xefsM58PgBMrvdYre8QyULY", ErrInvalidPublicKey},
+		// invalid checksum
+		{"xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHL", ErrInvalidChecksum},
+	}
+	for _, test := range tests {
+		_, err := ParseExtendedKey(test.key)
+		if !errors.Is(err, test.err) {
+			t.Fatalf("%s: expected %v, got %v", test.key, test.err, err)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package hdkey
+
+// ExtendedKeyOptions options for NewMaster and ParseExtendedKey functions
+type ExtendedKeyOptions struct {
+	// versions are the version bytes used to serialize and parse extended keys.
+	versions Versions
+}
+
+// ExtendedKeyOption a function that modifies ExtendedKeyOptions
+type ExtendedKeyOption func(*ExtendedKeyOptions)
+
+// WithVersions sets the version bytes used to serialize and parse extended keys.
+func WithVersions(versions Versions) func(*ExtendedKeyOptions) {
+	return func(options *ExtendedKeyOptions) {
+		options.versions = versions
+	}
+}
@@ -0,0 +1,199 @@
+package hdkey
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+const (
+	// PurposeBIP44 is the purpose of legacy P2PKH accounts.
+	PurposeBIP44 uint32 = 44
+	// PurposeBIP48 is the purpose of multi-signature accounts.
+	PurposeBIP48 uint32 = 48
+	// PurposeBIP49 is the purpose of P2WPKH-nested-in-P2SH accounts.
+	PurposeBIP49 uint32 = 49
+	// PurposeBIP84 is the purpose of native segwit P2WPKH accounts.
+	PurposeBIP84 uint32 = 84
+	// PurposeBIP86 is the purpose of single key P2TR accounts.
+	PurposeBIP86 uint32 = 86
+)
+
+var (
+	ErrInvalidPath = errors.New("invalid derivation path")
+)
+
+// DerivationPath is a BIP32 derivation path, the list of child indexes from the master key.
+// Hardened indexes include the HardenedKeyStart offset.
+//
+// A DerivationPath can be passed directly to ExtendedKey.DerivePath:
+//
+//	path, err := hdkey.ParseDerivationPath("m/84'/0'/0'/0/0")
+//	if err != nil {
+//		panic(err)
+//	}
+//	key, err := master.DerivePath(path...)
+type DerivationPath []uint32
+
+// ParseDerivationPath parses a derivation path such as "m/44'/0'/0'/0/0".
+// Hardened indexes may be marked with "'", "h" or "H", so "m/44h/0h/0h/0/0" is the same path.
+// The path "m" is the master key itself.
+func ParseDerivationPath(s string) (DerivationPath, error) {
+	components := strings.Split(s, "/")
+	if components[0] != "m" {
+		return nil, ErrInvalidPath
+	}
+	path := make(DerivationPath, 0, len(components)-1)
+	for _, component := range components[1:] {
+		var offset uint32
+		if trimmed, ok := trimHardened(component); ok {
+			component = trimmed
+			offset = HardenedKeyStart
+		}
+		// Signs are rejected explicitly, as ParseUint accepts a leading '+'.
+		if component == "" || component[0] < '0' || component[0] > '9' {
+			return nil, ErrInvalidPath
+		}
+		index, err := strconv.ParseUint(component, 10, 32)
+		if err != nil || uint32(index) >= HardenedKeyStart {
+			return nil, ErrInvalidPath
+		}
+		path = append(path, uint32(index)+offset)
+	}
+	return path, nil
+}
+
+func trimHardened(component string) (string, bool) {
+	for _, suffix := range []string{"'", "h", "H"} {
+		if trimmed, ok := strings.CutSuffix(component, suffix); ok {
+			return trimmed, true
+		}
+	}
+	return component, false
+}
+
+// String returns the canonical form of the path, using "'" to mark hardened indexes.
+func (p DerivationPath) String() string {
+	var b strings.Builder
+	b.WriteString("m")
+	for _, index := range p {
+		b.WriteString("/")
+		if index >= HardenedKeyStart {
+			b.WriteString(strconv.FormatUint(uint64(index-HardenedKeyStart), 10))
+			b.WriteString("'")
+		} else {
+			b.WriteString(strconv.FormatUint(uint64(index), 10))
+		}
+	}
+	return b.String()
+}
+
+// Purpose returns the purpose level of the path without the hardened offset, such as 44 for BIP44.
+// ok is false if the path has no such level.
+func (p DerivationPath) Purpose() (purpose uint32, ok bool) {
+	return p.level(0)
+}
+
+// CoinType returns the coin type level of the path without the hardened offset, such as 0 for bitcoin.
+// ok is false if the path has no such level.
+func (p DerivationPath) CoinType() (coinType uint32, ok bool) {
+	return p.level(1)
+}
+
+// Account returns the account level of the path without the hardened offset.
+// ok is false if the path has no such level.
+func (p DerivationPath) Account() (account uint32, ok bool) {
+	return p.level(2)
+}
+
+// ScriptType returns the script type level of a BIP48 path without the hardened offset.
+// ok is false if the path is not a BIP48 path or has no such level.
+func (p DerivationPath) ScriptType() (scriptType uint32, ok bool) {
+	if !p.isBIP48() {
+		return 0, false
+	}
+	return p.level(3)
+}
+
+// Change returns the change level of the path, 0 for external and 1 for internal addresses.
+// ok is false if the path has no such level.
+func (p DerivationPath) Change() (change uint32, ok bool) {
+	if p.isBIP48() {
+		return p.level(4)
+	}
+	return p.level(3)
+}
+
+// Index returns the address index level of the path.
+// ok is false if the path has no such level.
+func (p DerivationPath) Index() (index uint32, ok bool) {
+	if p.isBIP48() {
+		return p.level(5)
+	}
+	return p.level(4)
+}
+
+func (p DerivationPath) isBIP48() bool {
+	purpose, ok := p.Purpose()
+	return ok && purpose == PurposeBIP48
+}
+
+// level returns the index at the given depth without the hardened offset.
+func (p DerivationPath) level(depth int) (uint32, bool) {
+	if depth >= len(p) {
+		return 0, false
+	}
+	return p[depth] &^ HardenedKeyStart, true
+}
+
+// BIP44Path returns the BIP44 path m/44'/coinType'/account'/change/index.
+// It returns ErrInvalidPath if a level is not below HardenedKeyStart, as for all the paths below.
+func BIP44Path(coinType, account, change, index uint32) (DerivationPath, error) {
+	return newPath([]uint32{PurposeBIP44, coinType, account}, change, index)
+}
+
+// BIP49Path returns the BIP49 path m/49'/coinType'/account'/change/index.
+func BIP49Path(coinType, account, change, index uint32) (DerivationPath, error) {
+	return newPath([]uint32{PurposeBIP49, coinType, account}, change, index)
+}
+
+// BIP84Path returns the BIP84 path m/84'/coinType'/account'/change/index.
+func BIP84Path(coinType, account, change, index uint32) (DerivationPath, error) {
+	return newPath([]uint32{PurposeBIP84, coinType, account}, change, index)
+}
+
+// BIP86Path returns the BIP86 path m/86'/coinType'/account'/change/index.
+func BIP86Path(coinType, account, change, index uint32) (DerivationPath, error) {
+	return newPath([]uint32{PurposeBIP86, coinType, account}, change, index)
+}
+
+// BIP48Path returns the BIP48 path m/48'/coinType'/account'/scriptType'/change/index.
+// The script type is 1 for P2WSH-nested-in-P2SH and 2 for native P2WSH.
+func BIP48Path(coinType, account, scriptType, change, index uint32) (DerivationPath, error) {
+	return newPath([]uint32{PurposeBIP48, coinType, account, scriptType}, change, index)
+}
+
+// newPath returns the path of the hardened levels followed by change and index.
+// The levels are given without the hardened offset, so that none can overflow into another index.
+func newPath(hardened []uint32, change, index uint32) (DerivationPath, error) {
+	path := make(DerivationPath, 0, len(hardened)+2)
+	for _, level := range hardened {
+		if level >= HardenedKeyStart {
+			return nil, ErrInvalidPath
+		}
+		path = append(path, HardenedKeyStart+level)
+	}
+	if change >= HardenedKeyStart || index >= HardenedKeyStart {
+		return nil, ErrInvalidPath
+	}
+	return append(path, change, index), nil
+}
+
+// DeriveFromSeed creates the master key from the seed returned by bip39.NewSeed and derives the key at path.
+func DeriveFromSeed(seed []byte, path DerivationPath, opts ...ExtendedKeyOption) (*ExtendedKey, error) {
+	master, err := NewMaster(seed, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return master.DerivePath(path...)
+}
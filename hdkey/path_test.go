@@ -0,0 +1,186 @@
+package hdkey
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/gofika/bip39"
+)
+
+func TestParseDerivationPath(t *testing.T) {
+	tests := []struct {
+		path      string
+		canonical string
+		indexes   []uint32
+	}{
+		{"m", "m", []uint32{}},
+		{"m/0", "m/0", []uint32{0}},
+		{"m/44'/0'/0'/0/0", "m/44'/0'/0'/0/0", []uint32{h + 44, h, h, 0, 0}},
+		{"m/44h/0h/0h/1/7", "m/44'/0'/0'/1/7", []uint32{h + 44, h, h, 1, 7}},
+		{"m/84H/1H/2H/0/2147483647", "m/84'/1'/2'/0/2147483647", []uint32{h + 84, h + 1, h + 2, 0, 2147483647}},
+		{"m/2147483647'", "m/2147483647'", []uint32{h + 2147483647}},
+	}
+	for _, test := range tests {
+		path, err := ParseDerivationPath(test.path)
+		if err != nil {
+			t.Fatalf("%s: %v", test.path, err)
+		}
+		if !slices.Equal(path, test.indexes) {
+			t.Fatalf("%s: indexes mismatch: %v", test.path, []uint32(path))
+		}
+		if path.String() != test.canonical {
+			t.Fatalf("%s: canonical mismatch: %s", test.path, path)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"/0",
+		"M/0",
+		"m/",
+		"m//0",
+		"m/0/",
+		"m/-1",
+		"m/+1",
+		"m/ 1",
+		"m/a",
+		"m/1''",
+		"m/1x",
+		"m/2147483648",
+		"m/2147483648'",
+		"m/4294967296",
+		"44'/0'/0'",
+	}
+	for _, s := range invalid {
+		if _, err := ParseDerivationPath(s); !errors.Is(err, ErrInvalidPath) {
+			t.Fatalf("%q: expected ErrInvalidPath, got %v", s, err)
+		}
+	}
+}
+
+func TestDerivationPathLevels(t *testing.T) {
+	path, err := BIP84Path(0, 1, 1, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path.String() != "m/84'/0'/1'/1/5" {
+		t.Fatal("invalid BIP84 path")
+	}
+	if purpose, ok := path.Purpose(); !ok || purpose != PurposeBIP84 {
+		t.Fatal("invalid purpose")
+	}
+	if coinType, ok := path.CoinType(); !ok || coinType != 0 {
+		t.Fatal("invalid coin type")
+	}
+	if account, ok := path.Account(); !ok || account != 1 {
+		t.Fatal("invalid account")
+	}
+	if change, ok := path.Change(); !ok || change != 1 {
+		t.Fatal("invalid change")
+	}
+	if index, ok := path.Index(); !ok || index != 5 {
+		t.Fatal("invalid index")
+	}
+	if _, ok := path.ScriptType(); ok {
+		t.Fatal("unexpected script type")
+	}
+
+	path, err = BIP48Path(0, 0, 2, 0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path.String() != "m/48'/0'/0'/2'/0/3" {
+		t.Fatal("invalid BIP48 path")
+	}
+	if scriptType, ok := path.ScriptType(); !ok || scriptType != 2 {
+		t.Fatal("invalid script type")
+	}
+	if change, ok := path.Change(); !ok || change != 0 {
+		t.Fatal("invalid change")
+	}
+	if index, ok := path.Index(); !ok || index != 3 {
+		t.Fatal("invalid index")
+	}
+
+	for expected, newPath := range map[string]func() (DerivationPath, error){
+		"m/44'/0'/0'/0/0": func() (DerivationPath, error) { return BIP44Path(0, 0, 0, 0) },
+		"m/49'/1'/0'/1/2": func() (DerivationPath, error) { return BIP49Path(1, 0, 1, 2) },
+		"m/86'/0'/3'/0/9": func() (DerivationPath, error) { return BIP86Path(0, 3, 0, 9) },
+	} {
+		path, err := newPath()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if path.String() != expected {
+			t.Fatalf("%s: got %s", expected, path)
+		}
+	}
+
+	path, err = ParseDerivationPath("m/44'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := path.Account(); ok {
+		t.Fatal("unexpected account")
+	}
+}
+
+func TestPathBounds(t *testing.T) {
+	const maxIndex = HardenedKeyStart - 1
+	if _, err := BIP84Path(maxIndex, maxIndex, maxIndex, maxIndex); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := BIP48Path(maxIndex, maxIndex, maxIndex, maxIndex, maxIndex); err != nil {
+		t.Fatal(err)
+	}
+	invalid := []func() (DerivationPath, error){
+		func() (DerivationPath, error) { return BIP44Path(HardenedKeyStart, 0, 0, 0) },
+		func() (DerivationPath, error) { return BIP49Path(0, HardenedKeyStart, 0, 0) },
+		func() (DerivationPath, error) { return BIP84Path(0, 0, HardenedKeyStart, 0) },
+		func() (DerivationPath, error) { return BIP86Path(0, 0, 0, HardenedKeyStart) },
+		func() (DerivationPath, error) { return BIP84Path(1<<32-1, 0, 0, 0) },
+		func() (DerivationPath, error) { return BIP48Path(HardenedKeyStart, 0, 2, 0, 0) },
+		func() (DerivationPath, error) { return BIP48Path(0, HardenedKeyStart, 2, 0, 0) },
+		func() (DerivationPath, error) { return BIP48Path(0, 0, HardenedKeyStart+2, 0, 0) },
+		func() (DerivationPath, error) { return BIP48Path(0, 0, 2, HardenedKeyStart, 0) },
+		func() (DerivationPath, error) { return BIP48Path(0, 0, 2, 0, HardenedKeyStart) },
+	}
+	for i, newPath := range invalid {
+		if path, err := newPath(); !errors.Is(err, ErrInvalidPath) {
+			t.Fatalf("%d: expected ErrInvalidPath, got %s %v", i, path, err)
+		}
+	}
+}
+
+func TestDeriveFromSeed(t *testing.T) {
+	seed := bip39.NewSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+
+	// https://github.com/bitcoin/bips/blob/master/bip-0084.mediawiki#test-vectors
+	path, err := BIP84Path(0, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := DeriveFromSeed(seed, path[:3], WithVersions(Versions{
+		Private: [4]byte{0x04, 0xb2, 0x43, 0x0c},
+		Public:  [4]byte{0x04, 0xb2, 0x47, 0x46},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Neuter().String() != "zpub6rFR7y4Q2AijBEqTUquhVz398htDFrtymD9xYYfG1m4wAcvPhXNfE3EfH1r1ADqtfSdVCToUG868RvUUkgDKf31mGDtKsAYz2oz2AGutZYs" {
+		t.Fatalf("invalid BIP84 account key: %s", key.Neuter())
+	}
+
+	path, err = ParseDerivationPath("m/44h/0h/0h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err = DeriveFromSeed(seed, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Neuter().String() != "xpub6BosfCnifzxcFwrSzQiqu2DBVTshkCXacvNsWGYJVVhhawA7d4R5WSWGFNbi8Aw6ZRc1brxMyWMzG3DSSSSoekkudhUd9yLb6qx39T9nMdj" {
+		t.Fatalf("invalid BIP44 account key: %s", key.Neuter())
+	}
+}
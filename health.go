@@ -0,0 +1,139 @@
+package bip39
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+)
+
+// HealthTest is a test of CheckEntropyHealth.
+type HealthTest int
+
+const (
+	// RepetitionCountTest is the repetition count test of NIST SP 800-90B section 4.4.1,
+	// which fails when a byte repeats too many times in a row.
+	RepetitionCountTest HealthTest = iota + 1
+	// AdaptiveProportionTest is the adaptive proportion test of NIST SP 800-90B section 4.4.2,
+	// which fails when the first byte occurs too many times.
+	AdaptiveProportionTest
+	// RepeatingPatternTest fails when the entropy repeats a shorter pattern, such as 0102030401020304...
+	RepeatingPatternTest
+	// TestVectorTest fails when the entropy is the entropy of a published mnemonic, such as a BIP39 test vector.
+	TestVectorTest
+)
+
+func (t HealthTest) String() string {
+	switch t {
+	case RepetitionCountTest:
+		return "repetition count"
+	case AdaptiveProportionTest:
+		return "adaptive proportion"
+	case RepeatingPatternTest:
+		return "repeating pattern"
+	case TestVectorTest:
+		return "test vector"
+	}
+	return "unknown"
+}
+
+// HealthTestError reports entropy rejected by a health test.
+// It wraps ErrInvalidEntropy.
+type HealthTestError struct {
+	// Test is the test that failed.
+	Test HealthTest
+}
+
+func (e *HealthTestError) Error() string {
+	return fmt.Sprintf("%s: %s test failed", ErrInvalidEntropy, e.Test)
+}
+
+func (e *HealthTestError) Unwrap() error {
+	return ErrInvalidEntropy
+}
+
+const (
+	// healthFalsePositive is the false positive probability α of the NIST SP 800-90B tests, 2^-20 as recommended.
+	healthFalsePositive = 1.0 / (1 << 20)
+	// healthMinEntropy is the min-entropy H claimed for each byte of the entropy, full entropy.
+	healthMinEntropy = 8
+)
+
+// CheckEntropyHealth runs health tests on entropy before it is converted to a mnemonic,
+// and returns a HealthTestError naming the first test that failed.
+//
+// The repetition count and adaptive proportion tests of NIST SP 800-90B are run on the bytes of the entropy,
+// assuming full entropy and a false positive probability of 2^-20 each. Entropy repeating a shorter pattern,
+// which includes all zeros and all ones, and the entropy of published mnemonics, such as the BIP39 test vectors,
+// are rejected too, see Mnemonic.AssessRisk.
+//
+// Use it with WithEntropyValidator() option.
+func CheckEntropyHealth(entropy []byte) error {
+	if !repetitionCountTest(entropy) {
+		return &HealthTestError{Test: RepetitionCountTest}
+	}
+	if !adaptiveProportionTest(entropy) {
+		return &HealthTestError{Test: AdaptiveProportionTest}
+	}
+	if hasRepeatingPattern(entropy) {
+		return &HealthTestError{Test: RepeatingPatternTest}
+	}
+	if _, ok := knownEntropySource(entropy); ok {
+		return &HealthTestError{Test: TestVectorTest}
+	}
+	return nil
+}
+
+// repetitionCountTest reports whether no byte repeats in a row as many times as the cutoff
+// C = 1 + ceil(-log2(α) / H), that is 4 times.
+func repetitionCountTest(entropy []byte) bool {
+	cutoff := 1 + int(math.Ceil(-math.Log2(healthFalsePositive)/healthMinEntropy))
+	count := 1
+	for i := 1; i < len(entropy); i++ {
+		if entropy[i] != entropy[i-1] {
+			count = 1
+			continue
+		}
+		count++
+		if count >= cutoff {
+			return false
+		}
+	}
+	return true
+}
+
+// adaptiveProportionTest reports whether the first byte does not occur as many times as the cutoff
+// within the window, which is the whole entropy as it is shorter than the 512 bytes window of NIST SP 800-90B.
+func adaptiveProportionTest(entropy []byte) bool {
+	if len(entropy) == 0 {
+		return true
+	}
+	return bytes.Count(entropy, entropy[:1]) < adaptiveProportionCutoff(len(entropy))
+}
+
+// adaptiveProportionCutoff returns the smallest number of occurrences C of the first of window bytes
+// such that P(X >= C-1) <= α, where X ~ Binomial(window-1, 2^-H) is the number of other occurrences.
+func adaptiveProportionCutoff(window int) int {
+	p := math.Exp2(-healthMinEntropy)
+	n := window - 1
+	// tail is P(X >= k), computed by subtracting the probabilities of X = 0, 1, ... from 1.
+	tail := 1.0
+	probability := math.Pow(1-p, float64(n))
+	for k := 0; k <= n; k++ {
+		if tail <= healthFalsePositive {
+			return k + 1
+		}
+		tail -= probability
+		probability *= float64(n-k) / float64(k+1) * p / (1 - p)
+	}
+	return window + 1
+}
+
+// hasRepeatingPattern reports whether the entropy is a pattern of at most half its length repeated.
+func hasRepeatingPattern(entropy []byte) bool {
+	for period := 1; period <= len(entropy)/2; period++ {
+		if bytes.Equal(entropy[period:], entropy[:len(entropy)-period]) {
+			return true
+		}
+	}
+	return false
+}
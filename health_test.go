@@ -0,0 +1,95 @@
+package bip39
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestCheckEntropyHealth(t *testing.T) {
+	tests := []struct {
+		entropy string
+		test    HealthTest
+	}{
+		{"00000000000000000000000000000000", RepetitionCountTest},
+		{"ffffffffffffffffffffffffffffffff", RepetitionCountTest},
+		{"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f", RepetitionCountTest},
+		{"8ac3ebaeb0d6a1e2e2e2e22bd9a6c18e", RepetitionCountTest},
+		{"8c7b8c6f8c1e8c9a8cd28c3f8c558c01", AdaptiveProportionTest},
+		{"01020304050607080102030405060708", RepeatingPatternTest},
+		{"a1b2c3d4e5a1b2c3d4e5a1b2c3d4e5a1b2c3d4e5", RepeatingPatternTest},
+		{"9e885d952ad362caeb4efe34a8e91bd2", TestVectorTest},
+		{"f585c11aec520db57dd353c69554b21a89b20fb0650966fa0a9d6f74fd989d8f", TestVectorTest},
+		{"df9bf37e6fcdf9bf37e6fcdf9bf37e3c", TestVectorTest},
+		{"8ac3ebaeb0d6a1e2e2e22bd9a6c18e05", 0},
+	}
+	for _, test := range tests {
+		entropy, err := hex.DecodeString(test.entropy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if test.test == 0 {
+			if err := CheckEntropyHealth(entropy); err != nil {
+				t.Fatalf("%s: unexpected error %v", test.entropy, err)
+			}
+			continue
+		}
+		err = CheckEntropyHealth(entropy)
+		var healthErr *HealthTestError
+		if !errors.As(err, &healthErr) || healthErr.Test != test.test || !errors.Is(err, ErrInvalidEntropy) {
+			t.Fatalf("%s: invalid error %v", test.entropy, err)
+		}
+	}
+
+	// Random entropy passes, the false positive rate is about 2^-20.
+	random := NewDeterministicRandom([]byte("health"))
+	for range 10000 {
+		entropy := make([]byte, 32)
+		if _, err := io.ReadFull(random, entropy); err != nil {
+			t.Fatal(err)
+		}
+		if err := CheckEntropyHealth(entropy); err != nil {
+			t.Fatalf("%x: unexpected error %v", entropy, err)
+		}
+	}
+}
+
+func TestHealthTestCutoffs(t *testing.T) {
+	// 1 + ceil(20 / 8)
+	if !repetitionCountTest([]byte{1, 1, 1, 2, 2, 2}) || repetitionCountTest([]byte{1, 2, 2, 2, 2}) {
+		t.Fatal("invalid repetition count test")
+	}
+	if adaptiveProportionCutoff(16) != 5 || adaptiveProportionCutoff(32) != 6 {
+		t.Fatal("invalid adaptive proportion cutoff", adaptiveProportionCutoff(16), adaptiveProportionCutoff(32))
+	}
+	if HealthTest(0).String() != "unknown" || AdaptiveProportionTest.String() != "adaptive proportion" {
+		t.Fatal("invalid health test name")
+	}
+}
+
+func TestWithEntropyValidator(t *testing.T) {
+	m, err := NewMnemonic(WithEntropyValidator(CheckEntropyHealth))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var healthErr *HealthTestError
+	if _, err = m.EntropyToMnemonic(make([]byte, 16)); !errors.As(err, &healthErr) || healthErr.Test != RepetitionCountTest {
+		t.Fatal("invalid error", err)
+	}
+	if _, err = m.GenerateMnemonic(WithRandom(bytes.NewReader(make([]byte, 16)))); !errors.As(err, &healthErr) {
+		t.Fatal("invalid error", err)
+	}
+	if _, err = m.GenerateMnemonic(); err != nil {
+		t.Fatal(err)
+	}
+	// The validator is not applied by default.
+	m, err = NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.EntropyToMnemonic(make([]byte, 16)); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,215 @@
+package bip39
+
+import (
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// wordIndex looks up the words of a language by prefix.
+//
+// The words are sorted by their composed (NFC) form, in which each character of the wordlists is a single rune,
+// so the words starting with a prefix form a contiguous range and a prefix never ends inside a character:
+// "か" does not match "がぞう", and the Korean "가" does not match "각도".
+type wordIndex struct {
+	// composed are the NFC forms of the words, sorted.
+	composed []string
+	// positions are the positions of the composed words in the wordlist.
+	positions []int
+	// wordlist are the NFKD words in the order of the wordlist.
+	wordlist []string
+	// folded are the words in lower case without accents, sorted, for Latin wordlists only:
+	// the other scripts have no case, and removing their marks would match a prefix inside a character.
+	folded []string
+	// foldedPositions are the positions of the folded words in the wordlist.
+	foldedPositions []int
+}
+
+func newWordIndex(wordsMap map[string]int) *wordIndex {
+	x := &wordIndex{
+		composed:  make([]string, 0, len(wordsMap)),
+		positions: make([]int, 0, len(wordsMap)),
+		wordlist:  make([]string, len(wordsMap)),
+	}
+	for word, position := range wordsMap {
+		x.composed = append(x.composed, norm.NFC.String(word))
+		x.wordlist[position] = word
+	}
+	slices.Sort(x.composed)
+	for _, composed := range x.composed {
+		x.positions = append(x.positions, wordsMap[normalizeString(composed)])
+	}
+	if !isLatin(x.wordlist) {
+		return x
+	}
+	folded := make(map[string]int, len(x.wordlist))
+	for position, word := range x.wordlist {
+		folded[foldWord(word)] = position
+	}
+	x.folded = slices.Sorted(maps.Keys(folded))
+	for _, word := range x.folded {
+		x.foldedPositions = append(x.foldedPositions, folded[word])
+	}
+	return x
+}
+
+// isLatin reports whether the NFKD words are written with Latin letters and combining marks only.
+func isLatin(words []string) bool {
+	for _, word := range words {
+		for _, r := range word {
+			if !unicode.In(r, unicode.Latin, unicode.Mn) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// foldWord returns the word in lower case without accents, as typed on a keyboard or stamped on a metal plate.
+func foldWord(word string) string {
+	return strings.ToLower(strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+		return r
+	}, normalizeString(word)))
+}
+
+// lookup returns the range of the composed words that start with prefix.
+func (x *wordIndex) lookup(prefix string) (start, end int) {
+	prefix = norm.NFC.String(prefix)
+	start, _ = slices.BinarySearch(x.composed, prefix)
+	end = start + sort.Search(len(x.composed)-start, func(i int) bool {
+		return !strings.HasPrefix(x.composed[start+i], prefix)
+	})
+	return start, end
+}
+
+// completions returns the NFKD words starting with prefix, in the order of the wordlist.
+func (x *wordIndex) completions(prefix string) []string {
+	start, end := x.lookup(prefix)
+	if start == end {
+		return nil
+	}
+	if end-start == len(x.wordlist) {
+		return slices.Clone(x.wordlist)
+	}
+	positions := slices.Clone(x.positions[start:end])
+	slices.Sort(positions)
+	words := make([]string, len(positions))
+	for i, position := range positions {
+		words[i] = x.wordlist[position]
+	}
+	return words
+}
+
+// foldedCompletions returns the NFKD words starting with prefix in lower case and without accents,
+// in the order of the wordlist, or only the word equal to it if any. It returns none for other than Latin wordlists.
+func (x *wordIndex) foldedCompletions(prefix string) []string {
+	if x.folded == nil {
+		return nil
+	}
+	prefix = foldWord(prefix)
+	start, found := slices.BinarySearch(x.folded, prefix)
+	if found {
+		return []string{x.wordlist[x.foldedPositions[start]]}
+	}
+	end := start + sort.Search(len(x.folded)-start, func(i int) bool {
+		return !strings.HasPrefix(x.folded[start+i], prefix)
+	})
+	positions := slices.Clone(x.foldedPositions[start:end])
+	slices.Sort(positions)
+	var words []string
+	for _, position := range positions {
+		words = append(words, x.wordlist[position])
+	}
+	return words
+}
+
+// uniquePrefixLength returns the smallest number of characters that distinguishes all the words.
+func (x *wordIndex) uniquePrefixLength() int {
+	length := 1
+	for i := 1; i < len(x.composed); i++ {
+		// Cutting sorted words to the same number of characters keeps them sorted,
+		// so it is enough to distinguish consecutive words.
+		length = max(length, commonCharacters(x.composed[i-1], x.composed[i])+1)
+	}
+	return length
+}
+
+// shortestUniquePrefix returns the shortest prefix of the word that no other word starts with,
+// or the whole word if it is the prefix of other words.
+func (x *wordIndex) shortestUniquePrefix(word string) (string, bool) {
+	composed := norm.NFC.String(word)
+	i, ok := slices.BinarySearch(x.composed, composed)
+	if !ok {
+		return "", false
+	}
+	// The words sharing the most characters with the word are its neighbors.
+	length := 1
+	if i > 0 {
+		length = max(length, commonCharacters(x.composed[i-1], composed)+1)
+	}
+	if i < len(x.composed)-1 {
+		length = max(length, commonCharacters(x.composed[i+1], composed)+1)
+	}
+	runes := []rune(composed)
+	return normalizeString(string(runes[:min(length, len(runes))])), true
+}
+
+// commonCharacters returns the number of leading runes a and b have in common.
+func commonCharacters(a, b string) int {
+	n := 0
+	for a != "" && b != "" {
+		ra, sizeA := utf8.DecodeRuneInString(a)
+		rb, sizeB := utf8.DecodeRuneInString(b)
+		if ra != rb {
+			break
+		}
+		a, b = a[sizeA:], b[sizeB:]
+		n++
+	}
+	return n
+}
+
+// Completions returns the words of the language that start with prefix, in the order of the wordlist,
+// such as ["abandon", "ability", "able", "about", "above", "absent", ...] for "ab" in English.
+// The prefix matches whole characters only, in any normalization form. The words are in NFKD form.
+// An empty prefix returns all the words, and an unsupported language none.
+//
+// The words are looked up in a sorted index built once per language, so it is cheap to call on every keystroke.
+func (l Language) Completions(prefix string) []string {
+	data, ok := registry().language(l)
+	if !ok {
+		return nil
+	}
+	return data.index.completions(prefix)
+}
+
+// IsUnambiguousPrefix reports whether exactly one word of the language starts with prefix,
+// so that the word can be completed without typing more.
+// A whole word that is the prefix of other words, such as "act" in English, is ambiguous.
+func (l Language) IsUnambiguousPrefix(prefix string) bool {
+	data, ok := registry().language(l)
+	if !ok {
+		return false
+	}
+	start, end := data.index.lookup(prefix)
+	return end-start == 1
+}
+
+// ShortestUniquePrefix returns the shortest prefix that identifies the word in the language,
+// such as "aba" for "abandon" and "act" for "act" in English, or "が" for "がぞう" in Japanese.
+// ok is false if the word is not a word of the language.
+func (l Language) ShortestUniquePrefix(word string) (prefix string, ok bool) {
+	data, ok := registry().language(l)
+	if !ok {
+		return "", false
+	}
+	return data.index.shortestUniquePrefix(word)
+}
@@ -0,0 +1,114 @@
+package bip39
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestCompletions(t *testing.T) {
+	if !slices.Equal(English.Completions("abs"), []string{"absent", "absorb", "abstract", "absurd"}) {
+		t.Fatal("invalid completions", English.Completions("abs"))
+	}
+	if !slices.Equal(English.Completions("act"), []string{"act", "action", "actor", "actress", "actual"}) {
+		t.Fatal("invalid completions", English.Completions("act"))
+	}
+	if len(English.Completions("")) != 2048 || English.Completions("xyz") != nil || Language(100).Completions("a") != nil {
+		t.Fatal("invalid completions")
+	}
+	// Every completion starts with the prefix, in the order of the wordlist.
+	for lang, data := range registry().all() {
+		for _, word := range data.words[:50] {
+			prefix := string([]rune(norm.NFC.String(word))[:1])
+			completions := lang.Completions(prefix)
+			if !slices.Contains(completions, normalizeString(word)) {
+				t.Fatalf("%s: %s not completed from %s", lang, word, prefix)
+			}
+			for i, completion := range completions {
+				if !strings.HasPrefix(norm.NFC.String(completion), prefix) {
+					t.Fatalf("%s: invalid completion %s of %s", lang, completion, prefix)
+				}
+				if i > 0 && data.wordsMap[completions[i-1]] > data.wordsMap[completion] {
+					t.Fatalf("%s: completions of %s out of order", lang, prefix)
+				}
+			}
+		}
+	}
+}
+
+func TestCompletionsCharacters(t *testing.T) {
+	// Kana with and without dakuten are different characters.
+	for _, word := range Japanese.Completions("か") {
+		if strings.HasPrefix(norm.NFC.String(word), "が") {
+			t.Fatal("invalid completion", word)
+		}
+	}
+	if !slices.Contains(Japanese.Completions("が"), normalizeString("がぞう")) {
+		t.Fatal("invalid completions")
+	}
+	// Decomposed prefixes are matched too.
+	if !slices.Equal(Japanese.Completions(norm.NFD.String("がぞ")), Japanese.Completions("がぞ")) {
+		t.Fatal("invalid completions")
+	}
+	// Chinese words are single characters.
+	if !slices.Equal(ChineseSimplified.Completions("水"), []string{"水"}) || !ChineseSimplified.IsUnambiguousPrefix("水") {
+		t.Fatal("invalid completions")
+	}
+	if ChineseSimplified.Completions("水水") != nil {
+		t.Fatal("invalid completions")
+	}
+}
+
+func TestShortestUniquePrefix(t *testing.T) {
+	tests := []struct {
+		lang   Language
+		word   string
+		prefix string
+	}{
+		{English, "abandon", "aba"},
+		{English, "act", "act"},
+		{English, "zoo", "zoo"},
+		{English, "zebra", "zeb"},
+		{Japanese, "がぞう", "がぞ"},
+		{ChineseTraditional, "水", "水"},
+	}
+	for _, test := range tests {
+		prefix, ok := test.lang.ShortestUniquePrefix(test.word)
+		if !ok || prefix != normalizeString(test.prefix) {
+			t.Fatalf("%s: invalid prefix %s of %s", test.lang, prefix, test.word)
+		}
+	}
+	if _, ok := English.ShortestUniquePrefix("abando"); ok {
+		t.Fatal("unexpected prefix")
+	}
+	// The shortest unique prefix identifies the word and nothing shorter does.
+	for lang, data := range registry().all() {
+		for _, word := range data.words {
+			prefix, ok := lang.ShortestUniquePrefix(word)
+			if !ok {
+				t.Fatalf("%s: no prefix for %s", lang, word)
+			}
+			if prefix != normalizeString(word) && !lang.IsUnambiguousPrefix(prefix) {
+				t.Fatalf("%s: ambiguous prefix %s of %s", lang, prefix, word)
+			}
+			runes := []rune(norm.NFC.String(prefix))
+			if len(runes) > lang.UniquePrefixLength() {
+				t.Fatalf("%s: prefix %s of %s too long", lang, prefix, word)
+			}
+			if len(runes) > 1 && lang.IsUnambiguousPrefix(string(runes[:len(runes)-1])) {
+				t.Fatalf("%s: prefix %s of %s not the shortest", lang, prefix, word)
+			}
+		}
+	}
+}
+
+func TestIsUnambiguousPrefix(t *testing.T) {
+	if !English.IsUnambiguousPrefix("aban") || English.IsUnambiguousPrefix("ab") || English.IsUnambiguousPrefix("act") || English.IsUnambiguousPrefix("xyz") {
+		t.Fatal("invalid unambiguous prefix")
+	}
+	if Language(100).IsUnambiguousPrefix("aban") {
+		t.Fatal("invalid unambiguous prefix")
+	}
+}
@@ -1,9 +1,13 @@
 package bip39
 
 import (
+	"iter"
+	"math/bits"
 	"strings"
+	"sync"
 
 	"github.com/gofika/bip39/wordlists"
+	"golang.org/x/text/unicode/norm"
 )
 
 type Language byte
@@ -21,6 +25,40 @@ const (
 	Portuguese
 )
 
+// languageNames are the names of the languages, as used by the BIP39 wordlist files.
+var languageNames = map[Language]string{
+	English:            "english",
+	Japanese:           "japanese",
+	Korean:             "korean",
+	Spanish:            "spanish",
+	ChineseSimplified:  "chinese_simplified",
+	ChineseTraditional: "chinese_traditional",
+	French:             "french",
+	Italian:            "italian",
+	Czech:              "czech",
+	Portuguese:         "portuguese",
+}
+
+// String returns the name of the language as used by the BIP39 wordlist files, such as "chinese_simplified".
+func (l Language) String() string {
+	if name, ok := languageNames[l]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseLanguage returns the language of the given name, as returned by Language.String.
+// The name is matched case-insensitively, and "-" may be used instead of "_".
+func ParseLanguage(name string) (Language, bool) {
+	name = strings.ReplaceAll(strings.ToLower(name), "-", "_")
+	for lang, langName := range languageNames {
+		if langName == name {
+			return lang, true
+		}
+	}
+	return 0, false
+}
+
 const (
 	// Japanese uses ideographic spaces.
 	japaneseSpace = '\u3000' // '　'
@@ -42,55 +80,169 @@ var (
 type languageData struct {
 	words    []string
 	wordsMap map[string]int
+	// index looks up the words by prefix.
+	index *wordIndex
+	// prefixLength is the number of characters that identify every word, see UniquePrefixLength.
+	prefixLength int
+}
+
+// languageCount is the number of supported languages, which are numbered from 0.
+const languageCount = int(Portuguese) + 1
+
+// languageSet is a set of languages, the bit 1<<l standing for the language l.
+type languageSet uint16
+
+// allLanguages is the set of all supported languages.
+const allLanguages languageSet = 1<<languageCount - 1
+
+func (s languageSet) has(l Language) bool {
+	return s&(1<<l) != 0
+}
+
+// single returns the language of a set of exactly one language.
+func (s languageSet) single() (Language, bool) {
+	if s == 0 || s&(s-1) != 0 {
+		return 0, false
+	}
+	return Language(bits.TrailingZeros16(uint16(s))), true
+}
+
+// list returns the languages of the set, in ascending order.
+func (s languageSet) list() []Language {
+	languages := make([]Language, 0, bits.OnesCount16(uint16(s)))
+	for l := range Language(languageCount) {
+		if s.has(l) {
+			languages = append(languages, l)
+		}
+	}
+	return languages
+}
+
+// newLanguageSet returns the set of the supported languages among languages.
+func newLanguageSet(languages []Language) languageSet {
+	var s languageSet
+	for _, l := range languages {
+		if int(l) < languageCount {
+			s |= 1 << l
+		}
+	}
+	return s
+}
+
+// languageRegistry holds the data of all supported languages.
+// It is built once on first use and never modified afterwards, so it is safe for concurrent use without locking.
+type languageRegistry struct {
+	// data are the data of the languages, indexed by Language.
+	data [languageCount]*languageData
+	// words is a reverse index from the NFKD form of every word to the set of languages having it,
+	// so that detection takes one lookup per word.
+	words map[string]languageSet
 }
 
-func innerLanguages() map[Language]languageData {
-	return map[Language]languageData{
-		English: {
-			words:    wordlists.English,
-			wordsMap: wordlists.EnglishMap,
-		},
-		Japanese: {
-			words:    wordlists.Japanese,
-			wordsMap: wordlists.JapaneseMap,
-		},
-		Korean: {
-			words:    wordlists.Korean,
-			wordsMap: wordlists.KoreanMap,
-		},
-		Spanish: {
-			words:    wordlists.Spanish,
-			wordsMap: wordlists.SpanishMap,
-		},
-		ChineseSimplified: {
-			words:    wordlists.ChineseSimplified,
-			wordsMap: wordlists.ChineseSimplifiedMap,
-		},
-		ChineseTraditional: {
-			words:    wordlists.ChineseTraditional,
-			wordsMap: wordlists.ChineseTraditionalMap,
-		},
-		French: {
-			words:    wordlists.French,
-			wordsMap: wordlists.FrenchMap,
-		},
-		Italian: {
-			words:    wordlists.Italian,
-			wordsMap: wordlists.ItalianMap,
-		},
-		Czech: {
-			words:    wordlists.Czech,
-			wordsMap: wordlists.CzechMap,
-		},
-		Portuguese: {
-			words:    wordlists.Portuguese,
-			wordsMap: wordlists.PortugueseMap,
-		},
+// language returns the data of a language, or false if it is not supported.
+func (r *languageRegistry) language(l Language) (*languageData, bool) {
+	if int(l) >= languageCount {
+		return nil, false
 	}
+	return r.data[l], true
+}
+
+// all iterates over the supported languages and their data, in ascending order.
+func (r *languageRegistry) all() iter.Seq2[Language, *languageData] {
+	return func(yield func(Language, *languageData) bool) {
+		for l, data := range r.data {
+			if !yield(Language(l), data) {
+				return
+			}
+		}
+	}
+}
+
+// registry returns the registry of the supported languages.
+// The wordsMap of each language is keyed by the NFKD form of the words, so that
+// lookups match both composed and decomposed input.
+var registry = sync.OnceValue(func() *languageRegistry {
+	wordlists := [languageCount]struct {
+		words    []string
+		wordsMap map[string]int
+	}{
+		English:            {wordlists.English, wordlists.EnglishMap},
+		Japanese:           {wordlists.Japanese, wordlists.JapaneseMap},
+		Korean:             {wordlists.Korean, wordlists.KoreanMap},
+		Spanish:            {wordlists.Spanish, wordlists.SpanishMap},
+		ChineseSimplified:  {wordlists.ChineseSimplified, wordlists.ChineseSimplifiedMap},
+		ChineseTraditional: {wordlists.ChineseTraditional, wordlists.ChineseTraditionalMap},
+		French:             {wordlists.French, wordlists.FrenchMap},
+		Italian:            {wordlists.Italian, wordlists.ItalianMap},
+		Czech:              {wordlists.Czech, wordlists.CzechMap},
+		Portuguese:         {wordlists.Portuguese, wordlists.PortugueseMap},
+	}
+	r := &languageRegistry{words: make(map[string]languageSet)}
+	for l, wordlist := range wordlists {
+		data := &languageData{
+			words:    wordlist.words,
+			wordsMap: normalizeWordsMap(wordlist.wordsMap),
+		}
+		data.index = newWordIndex(data.wordsMap)
+		data.prefixLength = data.index.uniquePrefixLength()
+		r.data[l] = data
+		for word := range data.wordsMap {
+			r.words[word] |= 1 << l
+		}
+	}
+	return r
+})
+
+// isWordDelimiter reports whether r separates the words of a mnemonic, as in SplitMnemonic.
+func isWordDelimiter(r rune) bool {
+	if r == japaneseSpace {
+		return true
+	}
+	_, ok := delimiters[r]
+	return ok
+}
+
+// splitWords splits the mnemonic into words as SplitMnemonic does, without allocating: the words are not normalized.
+// It returns the number of words, or len(words)+1 if there are more than words can hold.
+func splitWords(words *[maxWordsCount]string, mnemonic string) int {
+	n := 0
+	for word := range strings.FieldsFuncSeq(strings.TrimSpace(mnemonic), isWordDelimiter) {
+		if n == len(words) {
+			return n + 1
+		}
+		words[n] = word
+		n++
+	}
+	return n
+}
+
+// normalizeUnknownWords converts to NFKD form the words that are not keys of known, which are in NFKD form,
+// so that words already normalized are not copied.
+func normalizeUnknownWords[V any](words []string, known map[string]V) {
+	for i, word := range words {
+		if _, ok := known[word]; !ok {
+			words[i] = normalizeString(word)
+		}
+	}
+}
+
+// normalizeWordsMap returns a copy of wordsMap keyed by the NFKD form of the words.
+func normalizeWordsMap(wordsMap map[string]int) map[string]int {
+	normalized := make(map[string]int, len(wordsMap))
+	for word, index := range wordsMap {
+		normalized[normalizeString(word)] = index
+	}
+	return normalized
+}
+
+// normalizeString returns the NFKD form of s, as required by BIP39 for both mnemonics and passphrases.
+func normalizeString(s string) string {
+	return norm.NFKD.String(s)
 }
 
 // SplitMnemonic splits a mnemonic into words and delimiter.
 // If the delimiter is a Japanese space, then the language must be Japanese.
+// The returned words are in NFKD form.
 //
 // Example:
 //
@@ -113,10 +265,16 @@ func SplitMnemonic(mnemonic string) (words []string, delimiter string) {
 		_, ok := delimiters[r]
 		return ok
 	})
+	// The Japanese space decomposes to a regular space under NFKD,
+	// so the words are normalized only after the delimiter is known.
+	for i, word := range words {
+		words[i] = normalizeString(word)
+	}
 	return
 }
 
 // NormalizeMnemonic normalizes the mnemonic.
+// Redundant whitespace is removed and every word is converted to NFKD form.
 //
 // Example:
 //
@@ -2,6 +2,7 @@ package bip39
 
 import (
 	"slices"
+	"sync"
 	"testing"
 )
 
@@ -36,3 +37,104 @@ func TestNormalizeMnemonic(t *testing.T) {
 		t.Fatal("invalid mnemonic")
 	}
 }
+
+func TestParseLanguage(t *testing.T) {
+	for lang := range registry().all() {
+		parsed, ok := ParseLanguage(lang.String())
+		if !ok || parsed != lang {
+			t.Fatal("invalid language")
+		}
+	}
+	if lang, ok := ParseLanguage("Chinese-Simplified"); !ok || lang != ChineseSimplified {
+		t.Fatal("invalid language")
+	}
+	if _, ok := ParseLanguage("klingon"); ok {
+		t.Fatal("unexpected language")
+	}
+	if Language(100).String() != "unknown" {
+		t.Fatal("invalid language name")
+	}
+}
+
+func TestLanguageRegistry(t *testing.T) {
+	// Every word of every language is in the reverse index with its language.
+	reg := registry()
+	for lang, data := range reg.all() {
+		for word := range data.wordsMap {
+			if !reg.words[word].has(lang) {
+				t.Fatalf("%s: %s not indexed", lang, word)
+			}
+		}
+	}
+	if _, ok := reg.language(Language(languageCount)); ok {
+		t.Fatal("unexpected language")
+	}
+
+	languages, ok := DetectLanguage("露 水 域 耀 搜 船 良 摘 士 近 桃 案")
+	if !ok || !slices.Equal(languages, []Language{ChineseSimplified, ChineseTraditional}) {
+		t.Fatal("invalid languages", languages)
+	}
+	if _, ok := DetectLanguage("露 水 域 耀 搜 船 良 摘 士 近 桃 案", WithLanguages([]Language{English, Language(100)})); ok {
+		t.Fatal("unexpected language")
+	}
+}
+
+func TestDetectLanguageConcurrently(t *testing.T) {
+	var wg sync.WaitGroup
+	for lang := range registry().all() {
+		m, err := NewMnemonic(WithLanguage(lang))
+		if err != nil {
+			t.Fatal(err)
+		}
+		mnemonic, err := m.GenerateMnemonic(WithEntropyBits(256))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for range 4 {
+			wg.Go(func() {
+				for range 100 {
+					if languages, ok := DetectLanguage(mnemonic); !ok || !slices.Contains(languages, lang) {
+						t.Errorf("%s: invalid languages %v", lang, languages)
+						return
+					}
+				}
+			})
+		}
+	}
+	wg.Wait()
+}
+
+func TestDetectLanguagesAllocations(t *testing.T) {
+	mnemonic := "void come effort suffer camp survey warrior heavy shoot primary clutch crush open amazing screen patrol group space point ten exist slush involve unfold"
+	allocs := testing.AllocsPerRun(100, func() {
+		if detectLanguages(mnemonic, &DetectLanguageOptions{}) != 1<<English {
+			t.Fatal("invalid language")
+		}
+	})
+	if allocs != 0 {
+		t.Fatalf("%v allocations", allocs)
+	}
+}
+
+// BenchmarkDetectLanguage detects the language of a 24 words mnemonic of each language.
+func BenchmarkDetectLanguage(b *testing.B) {
+	random := NewDeterministicRandom([]byte("benchmark"))
+	for lang := range registry().all() {
+		m, err := NewMnemonic(WithLanguage(lang))
+		if err != nil {
+			b.Fatal(err)
+		}
+		mnemonic, err := m.GenerateMnemonic(WithEntropyBits(256), WithRandom(random))
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(lang.String(), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, ok := DetectLanguage(mnemonic); !ok {
+					b.Fatal("invalid language")
+				}
+			}
+		})
+	}
+}
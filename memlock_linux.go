@@ -0,0 +1,31 @@
+//go:build linux
+
+package bip39
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// lockedAlloc allocates size bytes in anonymous pages of their own, locked into RAM and excluded from core dumps.
+// As the pages hold nothing else, unlocking them does not unlock other memory.
+func lockedAlloc(size int) ([]byte, error) {
+	b, err := unix.Mmap(-1, 0, size, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_ANON|unix.MAP_PRIVATE)
+	if err != nil {
+		return nil, fmt.Errorf("%w: mmap: %w", ErrMemoryLock, err)
+	}
+	if err := unix.Mlock(b); err != nil {
+		_ = unix.Munmap(b)
+		return nil, fmt.Errorf("%w: mlock: %w", ErrMemoryLock, err)
+	}
+	// Core dumps are a best effort, older kernels do not support MADV_DONTDUMP.
+	_ = unix.Madvise(b, unix.MADV_DONTDUMP)
+	return b, nil
+}
+
+// lockedFree unlocks and unmaps memory allocated by lockedAlloc, which must be wiped before.
+func lockedFree(b []byte) error {
+	return errors.Join(unix.Munlock(b), unix.Munmap(b))
+}
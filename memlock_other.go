@@ -0,0 +1,16 @@
+//go:build !linux
+
+package bip39
+
+import (
+	"fmt"
+	"runtime"
+)
+
+func lockedAlloc(size int) ([]byte, error) {
+	return nil, fmt.Errorf("%w: not supported on %s", ErrMemoryLock, runtime.GOOS)
+}
+
+func lockedFree(b []byte) error {
+	return nil
+}
@@ -0,0 +1,126 @@
+package bip39
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+var (
+	ErrNoEntropySources = errors.New("no entropy sources")
+	ErrDuplicateSource  = errors.New("duplicate entropy source")
+)
+
+// MixedSource describes a source of entropy added to an EntropyMixer, without its value.
+type MixedSource struct {
+	// Name is the name given to the source, such as "crypto/rand" or "d6".
+	Name string
+	// Hashed reports whether the input was hashed to the size of the entropy before being mixed, as text is.
+	Hashed bool
+}
+
+// EntropyMixer combines entropy from several sources, so that no source alone controls the result,
+// such as the OS random generator and dice rolled by an operator.
+//
+// The sources are combined with XOR, so the result is as unpredictable as the most unpredictable source,
+// provided the sources are independent: a source must not be chosen after seeing the others.
+// A source of all zeros leaves the result unchanged, and a source mixed twice would cancel itself,
+// so an input already mixed is rejected with ErrDuplicateSource.
+//
+// Example:
+//
+//	mixer, err := bip39.NewEntropyMixer(256)
+//	if err != nil {
+//		panic(err)
+//	}
+//	if err := mixer.AddReader("crypto/rand", rand.Reader); err != nil {
+//		panic(err)
+//	}
+//	if err := mixer.AddPhysical(dice); err != nil {
+//		panic(err)
+//	}
+//	entropy, err := mixer.Entropy()
+//	if err != nil {
+//		panic(err)
+//	}
+//	mnemonic, err := m.EntropyToMnemonic(entropy)
+type EntropyMixer struct {
+	mixed   []byte
+	sources []MixedSource
+	// digests are the SHA256 hashes of the inputs mixed so far, to reject an input mixed twice.
+	digests map[[sha256.Size]byte]struct{}
+}
+
+// NewEntropyMixer creates an EntropyMixer producing entropyBits of entropy.
+// The entropy bits must be in [128, 160, 192, 224, 256].
+func NewEntropyMixer(entropyBits int) (*EntropyMixer, error) {
+	if !isValidEntropyBits(entropyBits) {
+		return nil, ErrInvalidEntropy
+	}
+	return &EntropyMixer{
+		mixed:   make([]byte, entropyBits/8),
+		digests: make(map[[sha256.Size]byte]struct{}),
+	}, nil
+}
+
+// Add mixes entropy of the size of the result.
+// It returns ErrDuplicateSource if the same entropy was already mixed.
+func (x *EntropyMixer) Add(name string, entropy []byte) error {
+	if len(entropy) != len(x.mixed) {
+		return fmt.Errorf("%w: %s has %d bytes instead of %d", ErrInvalidEntropy, name, len(entropy), len(x.mixed))
+	}
+	return x.mix(MixedSource{Name: name}, entropy)
+}
+
+// AddPhysical mixes entropy gathered from dice rolls, coin flips or playing cards, named after its source.
+func (x *EntropyMixer) AddPhysical(physical *PhysicalEntropy) error {
+	return x.Add(physical.Source, physical.Entropy)
+}
+
+// AddReader mixes entropy of the size of the result read from random, such as crypto/rand.Reader.
+// If reading fails, the error is a RandomError.
+func (x *EntropyMixer) AddReader(name string, random io.Reader) error {
+	entropy := make([]byte, len(x.mixed))
+	if err := readRandom(random, entropy); err != nil {
+		return err
+	}
+	return x.Add(name, entropy)
+}
+
+// AddText mixes text of any length, such as typed by an operator, hashed with SHA256 to the size of the result.
+// The text is not normalized, so it must be typed the same way to reproduce the result.
+// It returns ErrDuplicateSource if the same text was already mixed.
+func (x *EntropyMixer) AddText(name string, text string) error {
+	hash := sha256.Sum256([]byte(text))
+	return x.mix(MixedSource{Name: name, Hashed: true}, hash[:len(x.mixed)])
+}
+
+// Sources returns the sources mixed so far, in the order they were added.
+func (x *EntropyMixer) Sources() []MixedSource {
+	return slices.Clone(x.sources)
+}
+
+// Entropy returns the mixed entropy, to be converted with EntropyToMnemonic.
+// It returns ErrNoEntropySources if no source was added.
+func (x *EntropyMixer) Entropy() ([]byte, error) {
+	if len(x.sources) == 0 {
+		return nil, ErrNoEntropySources
+	}
+	return slices.Clone(x.mixed), nil
+}
+
+// mix XORs entropy into the result, unless it was already mixed, as XOR would cancel it.
+func (x *EntropyMixer) mix(source MixedSource, entropy []byte) error {
+	digest := sha256.Sum256(entropy)
+	if _, ok := x.digests[digest]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicateSource, source.Name)
+	}
+	x.digests[digest] = struct{}{}
+	for i := range x.mixed {
+		x.mixed[i] ^= entropy[i]
+	}
+	x.sources = append(x.sources, source)
+	return nil
+}
@@ -0,0 +1,142 @@
+package bip39
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestEntropyMixer(t *testing.T) {
+	inputs := [][]byte{
+		bytes.Repeat([]byte{0x0f}, 16),
+		bytes.Repeat([]byte{0x33}, 16),
+		bytes.Repeat([]byte{0x7f}, 16),
+	}
+	mix := func(inputs [][]byte) []byte {
+		mixer, err := NewEntropyMixer(128)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, input := range inputs {
+			if err := mixer.Add("input", input); err != nil {
+				t.Fatal(err)
+			}
+		}
+		entropy, err := mixer.Entropy()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return entropy
+	}
+	expected := mix(inputs)
+	if !bytes.Equal(expected, bytes.Repeat([]byte{0x0f ^ 0x33 ^ 0x7f}, 16)) {
+		t.Fatal("invalid entropy", expected)
+	}
+	// An input of all zeros, at any position, leaves the output unchanged.
+	for i := range len(inputs) + 1 {
+		withZeros := slices.Insert(slices.Clone(inputs), i, make([]byte, 16))
+		if !bytes.Equal(mix(withZeros), expected) {
+			t.Fatalf("zeros at %d changed the entropy", i)
+		}
+	}
+	// Replacing any input with zeros leaves the contribution of the others unchanged.
+	for i := range inputs {
+		zeroed := slices.Clone(inputs)
+		zeroed[i] = make([]byte, 16)
+		others := slices.Delete(slices.Clone(inputs), i, i+1)
+		if !bytes.Equal(mix(zeroed), mix(others)) {
+			t.Fatalf("zeroed input %d changed the entropy", i)
+		}
+	}
+}
+
+func TestEntropyMixerSources(t *testing.T) {
+	mixer, err := NewEntropyMixer(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = mixer.Entropy(); err != ErrNoEntropySources {
+		t.Fatal("invalid error", err)
+	}
+	if err = mixer.AddReader("crypto/rand", rand.Reader); err != nil {
+		t.Fatal(err)
+	}
+	physical, err := EntropyFromCoins(strings.Repeat("HT", 128), 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = mixer.AddPhysical(physical); err != nil {
+		t.Fatal(err)
+	}
+	if err = mixer.AddText("operator", "the quick brown fox jumps over the lazy dog"); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(mixer.Sources(), []MixedSource{{Name: "crypto/rand"}, {Name: "coins"}, {Name: "operator", Hashed: true}}) {
+		t.Fatal("invalid sources", mixer.Sources())
+	}
+	entropy, err := mixer.Entropy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.EntropyToMnemonic(entropy); err != nil {
+		t.Fatal(err)
+	}
+
+	// Text is hashed with SHA256.
+	mixer, err = NewEntropyMixer(128)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = mixer.AddText("operator", "abc"); err != nil {
+		t.Fatal(err)
+	}
+	entropy, err = mixer.Entropy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(entropy) != "ba7816bf8f01cfea414140de5dae2223" {
+		t.Fatal("invalid entropy", hex.EncodeToString(entropy))
+	}
+
+	if err = mixer.Add("short", make([]byte, 15)); !errors.Is(err, ErrInvalidEntropy) {
+		t.Fatal("invalid error", err)
+	}
+	var randomErr *RandomError
+	if err = mixer.AddReader("short", bytes.NewReader(make([]byte, 15))); !errors.As(err, &randomErr) {
+		t.Fatal("invalid error", err)
+	}
+	if len(mixer.Sources()) != 1 {
+		t.Fatal("failed sources must not be recorded")
+	}
+
+	// A source mixed twice would cancel itself with XOR, it is rejected.
+	if err = mixer.AddText("again", "abc"); !errors.Is(err, ErrDuplicateSource) {
+		t.Fatal("invalid error", err)
+	}
+	hash := sha256.Sum256([]byte("abc"))
+	if err = mixer.Add("hash", hash[:16]); !errors.Is(err, ErrDuplicateSource) {
+		t.Fatal("invalid error", err)
+	}
+	if err = mixer.AddReader("same", bytes.NewReader(hash[:16])); !errors.Is(err, ErrDuplicateSource) {
+		t.Fatal("invalid error", err)
+	}
+	entropy, err = mixer.Entropy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(entropy) != "ba7816bf8f01cfea414140de5dae2223" || len(mixer.Sources()) != 1 {
+		t.Fatal("duplicate sources must not be mixed", hex.EncodeToString(entropy), mixer.Sources())
+	}
+	if _, err = NewEntropyMixer(100); err != ErrInvalidEntropy {
+		t.Fatal("invalid error", err)
+	}
+}
@@ -2,11 +2,8 @@ package bip39
 
 import (
 	"crypto/rand"
-	"crypto/sha256"
-	"encoding/binary"
 	"errors"
 	"fmt"
-	"math/big"
 	"slices"
 	"strings"
 )
@@ -19,42 +16,25 @@ var (
 	ErrChecksumIncorrect    = errors.New("checksum incorrect")
 )
 
-var (
-	// Some bitwise operands for working with big.Ints
-	shift11BitsMask = big.NewInt(2048)
-	bigOne          = big.NewInt(1)
-
-	// used to isolate the checksum bits from the entropy+checksum byte array
-	wordLengthChecksumMasks = map[int]*big.Int{
-		12: big.NewInt(15),
-		15: big.NewInt(31),
-		18: big.NewInt(63),
-		21: big.NewInt(127),
-		24: big.NewInt(255),
-	}
-
-	// used to use only the desired x of 8 available checksum bits.
-	// 256 bit (word length 24) requires all 8 bits of the checksum,
-	// and thus no shifting is needed for it (we would get a divByZero crash if we did)
-	wordLengthChecksumShifts = map[int]*big.Int{
-		12: big.NewInt(16),
-		15: big.NewInt(8),
-		18: big.NewInt(4),
-		21: big.NewInt(2),
-	}
-)
-
 // Mnemonic
 type Mnemonic struct {
+	language  Language
 	wordList  []string
 	wordMap   map[string]int
+	index     *wordIndex
 	delimiter string
+	// allowPrefixes accepts unique prefixes of the words, see WithPrefixes.
+	allowPrefixes bool
+	// validator checks the entropy before it is converted, see WithEntropyValidator.
+	validator func(entropy []byte) error
 }
 
 // NewMnemonic creates a new Mnemonic instance.
 //
 // The default language is English.
 // If you want to set the language, use WithLanguage() option.
+// If you want to accept abbreviated words, use WithPrefixes() option.
+// If you want to check the entropy, such as with CheckEntropyHealth, use WithEntropyValidator() option.
 func NewMnemonic(opts ...NewMnemonicOption) (*Mnemonic, error) {
 	options := &NewMnemonicOptions{
 		language: English,
@@ -68,16 +48,19 @@ func NewMnemonic(opts ...NewMnemonicOption) (*Mnemonic, error) {
 		delimiter = string(japaneseSpace)
 	}
 
-	possible := innerLanguages()
-	data, ok := possible[language]
+	data, ok := registry().language(language)
 	if !ok {
 		return nil, fmt.Errorf("language %d not supported", language)
 	}
 
 	return &Mnemonic{
-		wordList:  data.words,
-		wordMap:   data.wordsMap,
-		delimiter: delimiter,
+		language:      language,
+		wordList:      data.words,
+		wordMap:       data.wordsMap,
+		index:         data.index,
+		delimiter:     delimiter,
+		allowPrefixes: options.allowPrefixes,
+		validator:     options.validator,
 	}, nil
 }
 
@@ -87,9 +70,15 @@ func NewMnemonic(opts ...NewMnemonicOption) (*Mnemonic, error) {
 // If you want to set the entropy bits, use WithEntropyBits() option.
 // The entropy bits must be in [128, 160, 192, 224, 256].
 // Corresponding to [16, 20, 24, 28, 32] bytes.
+// The entropy is read from crypto/rand.Reader by default.
+// If you want to set the source of the entropy, use WithRandom() option.
+// If reading it fails, the error is a RandomError.
+// With WithEntropyValidator() option, the error of the validator is returned without retrying,
+// as entropy failing a health test means the source of randomness is suspect.
 func (m *Mnemonic) GenerateMnemonic(opts ...GenerateMnemonicOption) (string, error) {
 	options := &GenerateMnemonicOptions{
 		entropyBits: 128,
+		random:      rand.Reader,
 	}
 	for _, opt := range opts {
 		opt(options)
@@ -99,8 +88,7 @@ func (m *Mnemonic) GenerateMnemonic(opts ...GenerateMnemonicOption) (string, err
 	}
 	entropySize := options.entropyBits / 8
 	entropy := make([]byte, entropySize)
-	_, err := rand.Read(entropy)
-	if err != nil {
+	if err := readRandom(options.random, entropy); err != nil {
 		return "", err
 	}
 	return m.EntropyToMnemonic(entropy)
@@ -110,111 +98,87 @@ func (m *Mnemonic) GenerateMnemonic(opts ...GenerateMnemonicOption) (string, err
 //
 // The entropy must be in [16, 20, 24, 28, 32] bytes.
 // Corresponding to [128, 160, 192, 224, 256] bits.
+// With WithEntropyValidator() option, the entropy is checked by the validator first.
 func (m *Mnemonic) EntropyToMnemonic(entropy []byte) (string, error) {
 	if !isValidEntropyBits(len(entropy) * 8) {
 		return "", ErrInvalidEntropy
 	}
-
-	checksum, err := computeChecksum(entropy)
-	if err != nil {
-		return "", err
+	if m.validator != nil {
+		if err := m.validator(entropy); err != nil {
+			return "", err
+		}
 	}
-	entropyWithChecksum := append(entropy, checksum)
 
-	var words []string
-	for i := 0; i < len(entropyWithChecksum)*8/11; i++ {
-		index := extractBits(entropyWithChecksum, i*11, 11)
-		words = append(words, m.wordList[index])
+	var buf [maxEntropyWithChecksumSize]byte
+	copy(buf[:], entropy)
+	buf[len(entropy)] = checksumOf(entropy) << (8 - len(entropy)/4)
+	var words [maxWordsCount]string
+	wordsCount := len(entropy) * 3 / 4
+	for i := range wordsCount {
+		words[i] = m.wordList[extractBits(buf[:], i*bitsPerWord, bitsPerWord)]
 	}
-
-	return strings.Join(words, m.delimiter), nil
+	return strings.Join(words[:wordsCount], m.delimiter), nil
 }
 
 // EntropyFromMnemonic converts a mnemonic to entropy.
+// The mnemonic is normalized to NFKD form before the words are looked up.
+//
+// The error is a *MnemonicError reporting the number of words or every unknown word.
+// A valid mnemonic in NFKD form, as generated, is converted with the single allocation of the entropy.
+//
+// With WithPrefixes() option, the words may be abbreviated to unique prefixes,
+// and the error wraps a PrefixErrors if some of them cannot be expanded.
 func (m *Mnemonic) EntropyFromMnemonic(mnemonic string) ([]byte, error) {
-	words, _ := SplitMnemonic(mnemonic)
-	wordsCount := len(words)
-	if !isValidWordsSize(wordsCount) {
-		return nil, ErrInvalidNumberWords
-	}
-
-	// Decode the words into a big.Int.
-	b := big.NewInt(0)
-	for _, word := range words {
-		index, ok := m.wordMap[word]
-		if !ok {
-			return nil, ErrInvalidMnemonic
+	if m.allowPrefixes {
+		words, _ := SplitMnemonic(mnemonic)
+		if !isValidWordsSize(len(words)) {
+			return nil, m.newMnemonicError(ErrInvalidNumberWords, words)
 		}
-		var wordBytes [2]byte
-		binary.BigEndian.PutUint16(wordBytes[:], uint16(index))
-		b = b.Mul(b, shift11BitsMask)
-		b = b.Or(b, big.NewInt(0).SetBytes(wordBytes[:]))
-	}
-
-	// Build and add the checksum to the big.Int.
-	checksum := big.NewInt(0)
-	checksumMask := wordLengthChecksumMasks[wordsCount]
-	checksum = checksum.And(b, checksumMask)
-
-	b.Div(b, big.NewInt(0).Add(checksumMask, bigOne))
-
-	// The entropy is the underlying bytes of the big.Int. Any upper bytes of
-	// all 0's are not returned so we pad the beginning of the slice with empty
-	// bytes if necessary.
-	entropy := b.Bytes()
-	entropy = padByteSlice(entropy, wordsCount/3*4)
-
-	// Generate the checksum and compare with the one we got from the mneomnic.
-	entropyChecksumByte, err := computeChecksum(entropy)
-	if err != nil {
-		return nil, err
-	}
-	entropyChecksum := big.NewInt(int64(entropyChecksumByte))
-	if l := wordsCount; l != 24 {
-		checksumShift := wordLengthChecksumShifts[l]
-		entropyChecksum.Div(entropyChecksum, checksumShift)
+		expanded, err := expandPrefixes(m.wordMap, m.index, words)
+		if err != nil {
+			return nil, m.newMnemonicError(err, words)
+		}
+		return m.entropyFromWords(expanded)
 	}
-
-	if checksum.Cmp(entropyChecksum) != 0 {
-		return nil, ErrChecksumIncorrect
+	var words [maxWordsCount]string
+	n := splitWords(&words, mnemonic)
+	if !isValidWordsSize(n) {
+		all, _ := SplitMnemonic(mnemonic)
+		return nil, m.newMnemonicError(ErrInvalidNumberWords, all)
 	}
-	return entropy, nil
+	normalizeUnknownWords(words[:n], m.wordMap)
+	return m.entropyFromWords(words[:n])
 }
 
-func computeChecksum(entropy []byte) (byte, error) {
-	h := sha256.New()
-	if _, err := h.Write(entropy); err != nil {
-		return 0, err
+// entropyFromWords converts the NFKD words of a mnemonic to entropy. The error is a *MnemonicError.
+func (m *Mnemonic) entropyFromWords(words []string) ([]byte, error) {
+	var buf [maxEntropyWithChecksumSize]byte
+	if err := checkWords(m.wordMap, words, buf[:]); err != nil {
+		return nil, m.newMnemonicError(err, words)
 	}
-	return h.Sum(nil)[0], nil
+	return slices.Clone(buf[:len(words)/3*4]), nil
 }
 
-func extractBits(data []byte, start, length int) int {
-	var result int
-	for i := 0; i < length; i++ {
-		byteIndex := (start + i) / 8
-		bitIndex := (start + i) % 8
-		result = (result << 1) | int((data[byteIndex]>>(7-bitIndex))&1)
+// checkWords checks the number of words, the words of wordMap and the checksum of the NFKD words of a mnemonic,
+// without allocating. The entropy followed by the checksum is written to buf, which must be at least 33 bytes.
+func checkWords(wordMap map[string]int, words []string, buf []byte) error {
+	if !isValidWordsSize(len(words)) {
+		return ErrInvalidNumberWords
 	}
-	return result
-}
-
-// padByteSlice returns a byte slice of the given size with contents of the
-// given slice left padded and any empty spaces filled with 0's.
-func padByteSlice(slice []byte, length int) []byte {
-	offset := length - len(slice)
-	if offset <= 0 {
-		return slice
+	var indexes [maxWordsCount]int
+	for i, word := range words {
+		index, ok := wordMap[word]
+		if !ok {
+			return ErrInvalidMnemonic
+		}
+		indexes[i] = index
+	}
+	if !isChecksumValid(indexes[:len(words)], buf) {
+		return ErrChecksumIncorrect
 	}
-	newSlice := make([]byte, length)
-	copy(newSlice[offset:], slice)
-	return newSlice
+	return nil
 }
 
-// func normalizeString(s string) string {
-// 	return norm.NFKD.String(s)
-// }
-
 var validWordsSizes = []int{12, 15, 18, 21, 24}
 
 func isValidWordsSize(count int) bool {
@@ -1,11 +1,16 @@
 package bip39
 
 import (
+	"bytes"
+	"encoding/hex"
+	"strings"
 	"testing"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 func TestMnemonic(t *testing.T) {
-	possible := innerLanguages()
+	possible := registry().all()
 	for range 100 {
 		for lang := range possible {
 			m, err := NewMnemonic(WithLanguage(lang))
@@ -48,6 +53,18 @@ func TestJPNMnemonic(t *testing.T) {
 	}
 }
 
+func TestIsMnemonicValidJapaneseSpace(t *testing.T) {
+	const mnemonic = "legal winner thank year wave sausage worth useful legal winner thank yellow"
+	for _, s := range []string{mnemonic + "\u3000", "\u3000" + mnemonic, "\u3000 " + mnemonic + " \u3000"} {
+		if !IsMnemonicValid(s) {
+			t.Fatalf("%q: invalid mnemonic", s)
+		}
+	}
+	if IsMnemonicValid(strings.ReplaceAll(mnemonic, " ", "\u3000")) {
+		t.Fatal("english words delimited by japanese spaces valid")
+	}
+}
+
 func TestDelectLanguage(t *testing.T) {
 	// Test for Japanese
 	{
@@ -124,3 +141,141 @@ func TestNewSeed(t *testing.T) {
 		}
 	}
 }
+
+func TestJPNSeedVectors(t *testing.T) {
+	// https://github.com/bip32JP/bip32JP.github.io/blob/master/test_JP_BIP39.json
+	const passphrase = "㍍ガバヴァぱばぐゞちぢ十人十色"
+	vectors := []struct {
+		entropy  string
+		mnemonic string
+		seed     string
+	}{
+		{
+			entropy:  "00000000000000000000000000000000",
+			mnemonic: "あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あおぞら",
+			seed:     "a262d6fb6122ecf45be09c50492b31f92e9beb7d9a845987a02cefda57a15f9c467a17872029a9e92299b5cbdf306e3a0ee620245cbd508959b6cb7ca637bd55",
+		},
+		{
+			entropy:  "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+			mnemonic: "そつう　れきだい　ほんやく　わかす　りくつ　ばいか　ろせん　やちん　そつう　れきだい　ほんやく　わかめ",
+			seed:     "aee025cbe6ca256862f889e48110a6a382365142f7d16f2b9545285b3af64e542143a577e9c144e101a6bdca18f8d97ec3366ebf5b088b1c1af9bc31346e60d9",
+		},
+		{
+			entropy:  "80808080808080808080808080808080",
+			mnemonic: "そとづら　あまど　おおう　あこがれる　いくぶん　けいけん　あたえる　いよく　そとづら　あまど　おおう　あかちゃん",
+			seed:     "e51736736ebdf77eda23fa17e31475fa1d9509c78f1deb6b4aacfbd760a7e2ad769c714352c95143b5c1241985bcb407df36d64e75dd5a2b78ca5d2ba82a3544",
+		},
+		{
+			entropy:  "ffffffffffffffffffffffffffffffff",
+			mnemonic: "われる　われる　われる　われる　われる　われる　われる　われる　われる　われる　われる　ろんぶん",
+			seed:     "4cd2ef49b479af5e1efbbd1e0bdc117f6a29b1010211df4f78e2ed40082865793e57949236c43b9fe591ec70e5bb4298b8b71dc4b267bb96ed4ed282c8f7761c",
+		},
+	}
+	m, err := NewMnemonic(WithLanguage(Japanese))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range vectors {
+		// Check both the composed and the decomposed forms of the mnemonic and the passphrase.
+		for _, form := range []norm.Form{norm.NFC, norm.NFKD} {
+			mnemonic := form.String(v.mnemonic)
+			entropy, err := m.EntropyFromMnemonic(mnemonic)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(entropy) != v.entropy {
+				t.Fatal("entropy mismatch")
+			}
+			seed := NewSeed(mnemonic, WithPassphrase(form.String(passphrase)))
+			if hex.EncodeToString(seed) != v.seed {
+				t.Fatal("seed mismatch")
+			}
+		}
+	}
+}
+
+func TestKORSeedVectors(t *testing.T) {
+	// There are no official Korean vectors. These were computed with an independent implementation,
+	// Python's unicodedata and hashlib.pbkdf2_hmac, from the official korean.txt wordlist.
+	// The passphrase holds compatibility characters, "㈜" and "㎉", which NFKD decomposes to "(주)" and "kcal".
+	const passphrase = "㈜비밀번호 ㎉"
+	vectors := []struct {
+		entropy  string
+		mnemonic string
+		seed     string
+	}{
+		{
+			entropy:  "00000000000000000000000000000000",
+			mnemonic: "가격 가격 가격 가격 가격 가격 가격 가격 가격 가격 가격 가능",
+			seed:     "7b39b9b21f82e9ddf74862f1c52175ee320c5708529d2531f1c53e620103c9a5bb67ae5c8ed26a9f9904dad10fc015b9e060a1ecb8a947b9f6386d15c74c00b4",
+		},
+		{
+			entropy:  "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+			mnemonic: "실장 활동 큰절 흔적 형제 제대로 훈련 한글 실장 활동 큰절 흔히",
+			seed:     "005c41e1902daf924781c5da10d2b5037cac74fb58c0ce06f08d5fa236daac5c7590df8a1a65bd15104ca26b6e236419b577eccbc33d0d2a4085ffa0aed3ac0e",
+		},
+		{
+			entropy:  "ffffffffffffffffffffffffffffffffffffffffffffffff",
+			mnemonic: "힘껏 힘껏 힘껏 힘껏 힘껏 힘껏 힘껏 힘껏 힘껏 힘껏 힘껏 힘껏 힘껏 힘껏 힘껏 힘껏 힘껏 화살",
+			seed:     "9a267828d1c2e3a6ee71e199e1067776b6b32e036388354d43edf07977b6e32f4a654a9af2038b524929458bfdc6941d5e59f8f5638ed354b8c88c07a92582af",
+		},
+		{
+			entropy:  "80808080808080808080808080808080808080808080808080808080808080ff",
+			mnemonic: "실현 감소 기법 가상 걱정 무슨 가족 공간 실현 감소 기법 가상 걱정 무슨 가족 공간 실현 감소 기법 가상 걱정 무슨 감기 회원",
+			seed:     "1964d435543fdb00600804a59bdbcd1190ea315b68a4f81b417c46f81f83db9613dff28880d2d4ec5fd33f95f4d485ec76f50c5788a7c4322d394cda4446f2af",
+		},
+	}
+	m, err := NewMnemonic(WithLanguage(Korean))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range vectors {
+		// Check both the composed and the decomposed forms of the mnemonic and the passphrase.
+		for _, form := range []norm.Form{norm.NFC, norm.NFKD} {
+			mnemonic := form.String(v.mnemonic)
+			entropy, err := m.EntropyFromMnemonic(mnemonic)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(entropy) != v.entropy {
+				t.Fatal("entropy mismatch")
+			}
+			seed := NewSeed(mnemonic, WithPassphrase(form.String(passphrase)))
+			if hex.EncodeToString(seed) != v.seed {
+				t.Fatal("seed mismatch")
+			}
+		}
+	}
+}
+
+func TestComposedMnemonic(t *testing.T) {
+	possible := registry().all()
+	for lang := range possible {
+		m, err := NewMnemonic(WithLanguage(lang))
+		if err != nil {
+			t.Fatal(err)
+		}
+		mnemonic, err := m.GenerateMnemonic(WithEntropyBits(256))
+		if err != nil {
+			t.Fatal(err)
+		}
+		composed := norm.NFC.String(mnemonic)
+		if !IsMnemonicValid(composed) {
+			t.Fatal("invalid composed mnemonic")
+		}
+		entropy, err := m.EntropyFromMnemonic(mnemonic)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entropy2, err := m.EntropyFromMnemonic(composed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(entropy, entropy2) {
+			t.Fatal("entropy mismatch")
+		}
+		if !bytes.Equal(NewSeed(mnemonic), NewSeed(composed)) {
+			t.Fatal("seed mismatch")
+		}
+	}
+}
@@ -1,9 +1,17 @@
 package bip39
 
+import (
+	"io"
+)
+
 // DetectLanguageOptions options for DetectLanguage function
 type DetectLanguageOptions struct {
 	// only those languages are possible
 	languages []Language
+	// prefixes matches the prefixes of the words too
+	prefixes bool
+	// checksum drops the languages in which the checksum fails
+	checksum bool
 }
 
 // DetectLanguageOption a function that modifies DetectLanguageOptions
@@ -16,6 +24,23 @@ func WithLanguages(languages []Language) func(*DetectLanguageOptions) {
 	}
 }
 
+// WithPrefixDetection matches abbreviated words too, so that a language is possible
+// if every word of the mnemonic is a word of the language or the prefix of one.
+func WithPrefixDetection() func(*DetectLanguageOptions) {
+	return func(options *DetectLanguageOptions) {
+		options.prefixes = true
+	}
+}
+
+// WithChecksumDetection drops the languages in which the checksum of the mnemonic fails,
+// when it has a valid number of words. Mnemonics made of words shared by several languages,
+// such as English and French, are then usually detected in a single language.
+func WithChecksumDetection() func(*DetectLanguageOptions) {
+	return func(options *DetectLanguageOptions) {
+		options.checksum = true
+	}
+}
+
 // NewSeedOptions options for NewSeed function
 type NewSeedOptions struct {
 	// passphrase is an optional passphrase used to generate the seed.
@@ -36,6 +61,8 @@ func WithPassphrase(passphrase string) func(*NewSeedOptions) {
 type GenerateMnemonicOptions struct {
 	// entropyBits is the bits of the entropy.
 	entropyBits int
+	// random is the source of the entropy.
+	random io.Reader
 }
 
 // GenerateMnemonicOption a function that modifies GenerateMnemonicOptions
@@ -48,10 +75,22 @@ func WithEntropyBits(entropyBits int) func(*GenerateMnemonicOptions) {
 	}
 }
 
+// WithRandom sets the source of the entropy, crypto/rand.Reader by default.
+// See NewDeterministicRandom for reproducible tests.
+func WithRandom(random io.Reader) func(*GenerateMnemonicOptions) {
+	return func(options *GenerateMnemonicOptions) {
+		options.random = random
+	}
+}
+
 // NewMnemonicOptions options for NewMnemonic function
 type NewMnemonicOptions struct {
 	// language is the language of the mnemonic.
 	language Language
+	// allowPrefixes accepts unique prefixes of the words.
+	allowPrefixes bool
+	// validator checks the entropy before it is converted to a mnemonic.
+	validator func(entropy []byte) error
 }
 
 // NewMnemonicOption a function that modifies NewMnemonicOptions
@@ -63,3 +102,125 @@ func WithLanguage(language Language) func(*NewMnemonicOptions) {
 		options.language = language
 	}
 }
+
+// WithPrefixes accepts words abbreviated to a prefix that identifies a single word of the wordlist,
+// such as the first four letters of English words stored on metal backup plates.
+// See Language.UniquePrefixLength for the length that identifies every word.
+// A prefix matching no word is matched ignoring case, and accents in Latin wordlists, as plates are stamped.
+func WithPrefixes() func(*NewMnemonicOptions) {
+	return func(options *NewMnemonicOptions) {
+		options.allowPrefixes = true
+	}
+}
+
+// WithEntropyValidator sets a function checking the entropy before GenerateMnemonic and EntropyToMnemonic
+// convert it to a mnemonic, such as CheckEntropyHealth. Its error is returned as is.
+func WithEntropyValidator(validator func(entropy []byte) error) func(*NewMnemonicOptions) {
+	return func(options *NewMnemonicOptions) {
+		options.validator = validator
+	}
+}
+
+// SuggestOptions options for Suggest function
+type SuggestOptions struct {
+	// maxCandidates is the largest number of candidates suggested for each unknown word.
+	maxCandidates int
+	// maxDistance is the largest distance of the suggested candidates.
+	maxDistance float64
+}
+
+// SuggestOption a function that modifies SuggestOptions
+type SuggestOption func(*SuggestOptions)
+
+// WithMaxCandidates sets the largest number of candidates suggested for each unknown word.
+func WithMaxCandidates(maxCandidates int) func(*SuggestOptions) {
+	return func(options *SuggestOptions) {
+		options.maxCandidates = maxCandidates
+	}
+}
+
+// WithMaxDistance sets the largest distance of the suggested candidates, see Candidate.Distance.
+func WithMaxDistance(maxDistance float64) func(*SuggestOptions) {
+	return func(options *SuggestOptions) {
+		options.maxDistance = maxDistance
+	}
+}
+
+// RecoverOptions options for RecoverMissingWords function
+type RecoverOptions struct {
+	// placeholder is the word marking a missing word.
+	placeholder string
+	// wordsCount is the number of words of the complete mnemonic, 0 for the smallest valid one.
+	wordsCount int
+	// workers is the number of goroutines checking the combinations.
+	workers int
+	// progress is called with the number of combinations checked.
+	progress func(done, total uint64)
+}
+
+// RecoverOption a function that modifies RecoverOptions
+type RecoverOption func(*RecoverOptions)
+
+// WithPlaceholder sets the word marking a missing word at a known position.
+func WithPlaceholder(placeholder string) func(*RecoverOptions) {
+	return func(options *RecoverOptions) {
+		options.placeholder = placeholder
+	}
+}
+
+// WithWordsCount sets the number of words of the complete mnemonic: 12, 15, 18, 21 or 24.
+func WithWordsCount(wordsCount int) func(*RecoverOptions) {
+	return func(options *RecoverOptions) {
+		options.wordsCount = wordsCount
+	}
+}
+
+// WithWorkers sets the number of goroutines checking the combinations.
+func WithWorkers(workers int) func(*RecoverOptions) {
+	return func(options *RecoverOptions) {
+		options.workers = workers
+	}
+}
+
+// WithProgress sets a function called with the number of combinations checked so far and the total number of combinations.
+// It is called from the worker goroutines, but never concurrently, so it should return quickly.
+func WithProgress(progress func(done, total uint64)) func(*RecoverOptions) {
+	return func(options *RecoverOptions) {
+		options.progress = progress
+	}
+}
+
+// SecretOptions options for NewSecretMnemonic, NewSecretSeed and NewSecretPassphrase functions
+type SecretOptions struct {
+	// revealOnMarshal marshals the raw value instead of failing.
+	revealOnMarshal bool
+}
+
+// SecretOption a function that modifies SecretOptions
+type SecretOption func(*SecretOptions)
+
+// WithRevealOnMarshal marshals the raw value of the secret with json.Marshal and other encoders of encoding.TextMarshaler,
+// which fail with ErrSecretMarshal otherwise. Use it only for secrets meant to be stored, such as in an encrypted file.
+func WithRevealOnMarshal() func(*SecretOptions) {
+	return func(options *SecretOptions) {
+		options.revealOnMarshal = true
+	}
+}
+
+// SecretBufferOptions options for NewSeedBuffer, EntropyFromMnemonicBuffer and EntropyToMnemonicBuffer functions
+type SecretBufferOptions struct {
+	// lock locks the memory of the buffer into RAM.
+	lock bool
+}
+
+// SecretBufferOption a function that modifies SecretBufferOptions
+type SecretBufferOption func(*SecretBufferOptions)
+
+// WithMemoryLock allocates the buffer in its own pages of memory locked with mlock, so that it is never written to swap,
+// and excluded from core dumps. It is supported on Linux only, the error wraps ErrMemoryLock on other systems,
+// or when locking fails, such as above the RLIMIT_MEMLOCK limit.
+func WithMemoryLock() func(*SecretBufferOptions) {
+	return func(options *SecretBufferOptions) {
+		options.lock = true
+	}
+}
@@ -0,0 +1,177 @@
+package bip39
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Phrase is a parsed and validated mnemonic: its language, the indexes of its words in the wordlist,
+// its entropy and its checksum. It is a value, its accessors return copies.
+//
+// Build it with ParsePhrase, Mnemonic.ParsePhrase, Mnemonic.PhraseFromEntropy or Mnemonic.PhraseFromIndices,
+// instead of passing strings around and splitting, detecting and converting them again.
+// The zero Phrase has no words.
+type Phrase struct {
+	language  Language
+	delimiter string
+	indices   []int
+	entropy   []byte
+	checksum  byte
+}
+
+// ParsePhrase parses a mnemonic of any language.
+//
+// The language is detected with DetectLanguage and the options, and the first detected language
+// in which the checksum is valid is used, as the wordlists share some words.
+// With WithPrefixDetection() option, the words may be unique prefixes.
+func ParsePhrase(mnemonic string, opts ...DetectLanguageOption) (Phrase, error) {
+	options := &DetectLanguageOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	languages, ok := DetectLanguage(mnemonic, opts...)
+	if !ok {
+		return Phrase{}, fmt.Errorf("%w: unknown language", ErrInvalidMnemonic)
+	}
+	var firstErr error
+	for _, language := range languages {
+		newOpts := []NewMnemonicOption{WithLanguage(language)}
+		if options.prefixes {
+			newOpts = append(newOpts, WithPrefixes())
+		}
+		m, err := NewMnemonic(newOpts...)
+		if err != nil {
+			return Phrase{}, err
+		}
+		phrase, err := m.ParsePhrase(mnemonic)
+		if err == nil {
+			return phrase, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return Phrase{}, firstErr
+}
+
+// ParsePhrase parses a mnemonic of the language of m, as EntropyFromMnemonic does.
+func (m *Mnemonic) ParsePhrase(mnemonic string) (Phrase, error) {
+	entropy, err := m.EntropyFromMnemonic(mnemonic)
+	if err != nil {
+		return Phrase{}, err
+	}
+	return m.newPhrase(entropy), nil
+}
+
+// PhraseFromEntropy builds the phrase of entropy, as EntropyToMnemonic does.
+// With WithEntropyValidator() option, the entropy is checked by the validator first.
+func (m *Mnemonic) PhraseFromEntropy(entropy []byte) (Phrase, error) {
+	if !isValidEntropyBits(len(entropy) * 8) {
+		return Phrase{}, ErrInvalidEntropy
+	}
+	if m.validator != nil {
+		if err := m.validator(entropy); err != nil {
+			return Phrase{}, err
+		}
+	}
+	return m.newPhrase(slices.Clone(entropy)), nil
+}
+
+// PhraseFromIndices builds the phrase of the indexes of its words in the wordlist, from 0 to 2047.
+// The last index must hold a valid checksum.
+func (m *Mnemonic) PhraseFromIndices(indices []int) (Phrase, error) {
+	if !isValidWordsSize(len(indices)) {
+		return Phrase{}, ErrInvalidNumberWords
+	}
+	for i, index := range indices {
+		if index < 0 || index >= len(m.wordList) {
+			return Phrase{}, fmt.Errorf("%w: invalid index %d at word %d", ErrInvalidMnemonic, index, i+1)
+		}
+	}
+	var buf [maxEntropyWithChecksumSize]byte
+	if !isChecksumValid(indices, buf[:]) {
+		return Phrase{}, ErrChecksumIncorrect
+	}
+	checksumBits := len(indices) / 3
+	return Phrase{
+		language:  m.language,
+		delimiter: m.delimiter,
+		indices:   slices.Clone(indices),
+		entropy:   slices.Clone(buf[:checksumBits*4]),
+		checksum:  buf[checksumBits*4] >> (8 - checksumBits),
+	}, nil
+}
+
+// newPhrase returns the phrase of valid entropy, which it keeps.
+func (m *Mnemonic) newPhrase(entropy []byte) Phrase {
+	checksumBits := len(entropy) / 4
+	checksum := checksumOf(entropy)
+	var buf [maxEntropyWithChecksumSize]byte
+	copy(buf[:], entropy)
+	buf[len(entropy)] = checksum << (8 - checksumBits)
+	indices := make([]int, checksumBits*3)
+	for i := range indices {
+		indices[i] = extractBits(buf[:], i*bitsPerWord, bitsPerWord)
+	}
+	return Phrase{
+		language:  m.language,
+		delimiter: m.delimiter,
+		indices:   indices,
+		entropy:   entropy,
+		checksum:  checksum,
+	}
+}
+
+// Language returns the language of the words.
+func (p Phrase) Language() Language {
+	return p.language
+}
+
+// Words returns the words as written by EntropyToMnemonic, in the form of the wordlist.
+func (p Phrase) Words() []string {
+	if len(p.indices) == 0 {
+		return nil
+	}
+	data, ok := registry().language(p.language)
+	if !ok {
+		return nil
+	}
+	words := make([]string, len(p.indices))
+	for i, index := range p.indices {
+		words[i] = data.words[index]
+	}
+	return words
+}
+
+// Indices returns the indexes of the words in the wordlist, from 0 to 2047.
+func (p Phrase) Indices() []int {
+	return slices.Clone(p.indices)
+}
+
+// Entropy returns the entropy.
+func (p Phrase) Entropy() []byte {
+	return slices.Clone(p.entropy)
+}
+
+// Bits returns the number of bits of the entropy, from 128 to 256.
+func (p Phrase) Bits() int {
+	return len(p.entropy) * 8
+}
+
+// Checksum returns the checksum bits, the first WordCount()/3 bits of the SHA256 hash of the entropy,
+// in the least significant bits.
+func (p Phrase) Checksum() byte {
+	return p.checksum
+}
+
+// WordCount returns the number of words, from 12 to 24.
+func (p Phrase) WordCount() int {
+	return len(p.indices)
+}
+
+// String returns the mnemonic, the words separated by the delimiter of the language:
+// an ideographic space for Japanese, a space otherwise. It is the mnemonic EntropyToMnemonic returns.
+func (p Phrase) String() string {
+	return strings.Join(p.Words(), p.delimiter)
+}
@@ -0,0 +1,135 @@
+package bip39
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestParsePhrase(t *testing.T) {
+	phrase, err := ParsePhrase("legal winner thank year wave sausage worth useful legal winner thank yellow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if phrase.Language() != English || phrase.WordCount() != 12 || phrase.Bits() != 128 {
+		t.Fatalf("invalid phrase %s %d %d", phrase.Language(), phrase.WordCount(), phrase.Bits())
+	}
+	if hex.EncodeToString(phrase.Entropy()) != "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f" {
+		t.Fatalf("invalid entropy %x", phrase.Entropy())
+	}
+	// yellow is the word 2040, 0b11111111000, whose last 4 bits are the checksum.
+	if phrase.Checksum() != 0b1000 || phrase.Indices()[11] != 2040 || phrase.Words()[11] != "yellow" {
+		t.Fatalf("invalid checksum %b %v", phrase.Checksum(), phrase.Indices())
+	}
+	if phrase.String() != "legal winner thank year wave sausage worth useful legal winner thank yellow" {
+		t.Fatalf("invalid string %q", phrase.String())
+	}
+
+	// The accessors return copies.
+	phrase.Entropy()[0] = 0
+	phrase.Indices()[0] = 0
+	phrase.Words()[0] = ""
+	if phrase.Entropy()[0] != 0x7f || phrase.Words()[0] != "legal" {
+		t.Fatal("phrase modified")
+	}
+
+	japanese := "そつう　れきだい　ほんやく　わかす　りくつ　ばいか　ろせん　やちん　そつう　れきだい　ほんやく　わかめ"
+	phrase, err = ParsePhrase(japanese)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if phrase.Language() != Japanese || normalizeString(phrase.String()) != normalizeString(japanese) {
+		t.Fatalf("invalid phrase %s %q", phrase.Language(), phrase.String())
+	}
+
+	phrase, err = ParsePhrase("lega winn than year wave saus wort usef lega winn than yell", WithPrefixDetection())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if phrase.Words()[0] != "legal" {
+		t.Fatalf("invalid words %v", phrase.Words())
+	}
+
+	if _, err := ParsePhrase("legal winner thank year wave sausage worth useful legal winner thank year"); !errors.Is(err, ErrChecksumIncorrect) {
+		t.Fatalf("invalid error %v", err)
+	}
+	if _, err := ParsePhrase("legal winner thank year wave sausage worth useful legal winner thank zzz"); !errors.Is(err, ErrInvalidMnemonic) {
+		t.Fatalf("invalid error %v", err)
+	}
+	if s := (Phrase{}).String(); s != "" {
+		t.Fatalf("invalid zero phrase %q", s)
+	}
+}
+
+func TestPhraseConstructors(t *testing.T) {
+	// The phrases write the words as EntropyToMnemonic does, whatever the form of the wordlist.
+	for language := range registry().all() {
+		m, err := NewMnemonic(WithLanguage(language))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, size := range []int{16, 20, 24, 28, 32} {
+			entropy := make([]byte, size)
+			for i := range entropy {
+				entropy[i] = byte(i * 37)
+			}
+			fromEntropy, err := m.PhraseFromEntropy(entropy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			mnemonic, err := m.EntropyToMnemonic(entropy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			parsed, err := m.ParsePhrase(mnemonic)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fromIndices, err := m.PhraseFromIndices(parsed.Indices())
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, phrase := range []Phrase{fromEntropy, parsed, fromIndices} {
+				if phrase.String() != mnemonic || !bytes.Equal(phrase.Entropy(), entropy) || phrase.WordCount() != size*3/4 ||
+					phrase.Checksum() != fromEntropy.Checksum() || !slices.Equal(phrase.Indices(), parsed.Indices()) {
+					t.Fatalf("%s, %d bytes: invalid phrase %q %x %b", language, size, phrase, phrase.Entropy(), phrase.Checksum())
+				}
+			}
+		}
+	}
+
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.PhraseFromEntropy(make([]byte, 15)); !errors.Is(err, ErrInvalidEntropy) {
+		t.Fatalf("invalid error %v", err)
+	}
+	if _, err := m.PhraseFromIndices(make([]int, 11)); !errors.Is(err, ErrInvalidNumberWords) {
+		t.Fatalf("invalid error %v", err)
+	}
+	indices := make([]int, 12)
+	indices[11] = 2048
+	if _, err := m.PhraseFromIndices(indices); !errors.Is(err, ErrInvalidMnemonic) {
+		t.Fatalf("invalid error %v", err)
+	}
+	// abandon ... abandon abandon has an incorrect checksum, abandon ... abandon about a correct one.
+	indices[11] = 0
+	if _, err := m.PhraseFromIndices(indices); !errors.Is(err, ErrChecksumIncorrect) {
+		t.Fatalf("invalid error %v", err)
+	}
+	indices[11] = 3
+	if _, err := m.PhraseFromIndices(indices); err != nil {
+		t.Fatal(err)
+	}
+
+	validated, err := NewMnemonic(WithEntropyValidator(CheckEntropyHealth))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := validated.PhraseFromEntropy(make([]byte, 16)); !errors.Is(err, ErrInvalidEntropy) {
+		t.Fatalf("invalid error %v", err)
+	}
+}
@@ -0,0 +1,300 @@
+package bip39
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrInvalidDiceRoll     = errors.New("invalid dice roll")
+	ErrInvalidCoinFlip     = errors.New("invalid coin flip")
+	ErrInvalidCard         = errors.New("invalid card")
+	ErrInsufficientEntropy = errors.New("insufficient entropy")
+)
+
+// deckSize is the number of cards of a deck.
+const deckSize = 52
+
+// PhysicalEntropy is entropy gathered from dice rolls, coin flips or playing cards.
+//
+// The inputs make a number uniformly distributed in [0, size), such as [0, 6^50) for 50 rolls of a d6.
+// Taking it modulo a power of two would favor small values. Instead, if the number is below the largest
+// power of two 2^k not greater than size, its k bits are used. Otherwise the number minus 2^k is uniformly
+// distributed in [0, size-2^k), and the same applies again, until a single value remains, which is rejected.
+type PhysicalEntropy struct {
+	// Entropy is the entropy, to be converted with EntropyToMnemonic.
+	Entropy []byte
+	// Source describes the source, such as "d6", "d20", "coins" or "cards".
+	Source string
+	// Inputs is the number of rolls, flips or cards.
+	Inputs int
+	// Bits is the number of unbiased bits the inputs supplied.
+	// Only the first len(Entropy)*8 bits are used, the others are discarded.
+	Bits int
+}
+
+// EntropyFromDice converts rolls of a dice with the given number of sides to entropyBits of entropy,
+// such as 50 rolls of a d6 to 128 bits of entropy.
+//
+// The rolls are numbers from 1 to sides, separated by spaces or commas. For dice with less than 10 sides,
+// they may also be written without separators, such as "3614625".
+// At least MinDiceRolls(sides, entropyBits) rolls are required. The unbiased conversion may still supply
+// less bits than requested, with a probability below 2^-20: ErrInsufficientEntropy is returned then.
+//
+// The rolls are the digits of a number in base sides, which is converted to bits without modulo bias,
+// as explained in PhysicalEntropy.
+func EntropyFromDice(rolls string, sides int, entropyBits int) (*PhysicalEntropy, error) {
+	if sides < 2 {
+		return nil, fmt.Errorf("%w: %d sides", ErrInvalidDiceRoll, sides)
+	}
+	if !isValidEntropyBits(entropyBits) {
+		return nil, ErrInvalidEntropy
+	}
+	var values []string
+	if sides < 10 && !strings.ContainsFunc(strings.TrimSpace(rolls), isInputSeparator) {
+		values = strings.Split(strings.TrimSpace(rolls), "")
+	} else {
+		values = strings.FieldsFunc(rolls, isInputSeparator)
+	}
+	if len(values) < MinDiceRolls(sides, entropyBits) {
+		return nil, fmt.Errorf("%w: %d rolls of %d required, got %d", ErrInsufficientEntropy, MinDiceRolls(sides, entropyBits), sides, len(values))
+	}
+	value, size := new(big.Int), big.NewInt(1)
+	base := big.NewInt(int64(sides))
+	for i, s := range values {
+		roll, err := strconv.Atoi(s)
+		if err != nil || roll < 1 || roll > sides {
+			return nil, fmt.Errorf("%w: %q at roll %d", ErrInvalidDiceRoll, s, i+1)
+		}
+		value.Mul(value, base).Add(value, big.NewInt(int64(roll-1)))
+		size.Mul(size, base)
+	}
+	return newPhysicalEntropy(fmt.Sprintf("d%d", sides), len(values), entropyBits, uniformBits(value, size))
+}
+
+// EntropyFromCoins converts coin flips to entropyBits of entropy, one bit per flip.
+// The flips are "H" for heads, the bit 1, and "T" for tails, the bit 0, or directly "1" and "0",
+// case insensitive and optionally separated by spaces or commas. At least entropyBits flips are required.
+func EntropyFromCoins(flips string, entropyBits int) (*PhysicalEntropy, error) {
+	if !isValidEntropyBits(entropyBits) {
+		return nil, ErrInvalidEntropy
+	}
+	var bits []byte
+	for _, r := range flips {
+		switch unicode.ToUpper(r) {
+		case 'H', '1':
+			bits = append(bits, 1)
+		case 'T', '0':
+			bits = append(bits, 0)
+		default:
+			if !isInputSeparator(r) {
+				return nil, fmt.Errorf("%w: %q at flip %d", ErrInvalidCoinFlip, r, len(bits)+1)
+			}
+		}
+	}
+	if len(bits) < MinCoinFlips(entropyBits) {
+		return nil, fmt.Errorf("%w: %d flips required, got %d", ErrInsufficientEntropy, MinCoinFlips(entropyBits), len(bits))
+	}
+	return newPhysicalEntropy("coins", len(bits), entropyBits, bits)
+}
+
+// EntropyFromCards converts the order of shuffled playing cards to entropyBits of entropy.
+//
+// The cards are a rank, "A", "2" to "10" or "T", "J", "Q" or "K", followed by a suit, "S", "H", "D" or "C",
+// case insensitive and separated by spaces or commas, such as "AS 10H KD 2C".
+// A full deck holds about 225 bits, so more decks are needed for 256 bits of entropy:
+// a new deck starts when a card of the current deck appears again.
+// At least MinCards(entropyBits) cards are required. The unbiased conversion may still supply
+// less bits than requested, with a probability below 2^-20: ErrInsufficientEntropy is returned then.
+//
+// The order of the cards of each deck is numbered by its Lehmer code, which is converted to bits without modulo bias,
+// as explained in PhysicalEntropy.
+func EntropyFromCards(cards string, entropyBits int) (*PhysicalEntropy, error) {
+	if !isValidEntropyBits(entropyBits) {
+		return nil, ErrInvalidEntropy
+	}
+	values := strings.FieldsFunc(cards, isInputSeparator)
+	if len(values) < MinCards(entropyBits) {
+		return nil, fmt.Errorf("%w: %d cards required, got %d", ErrInsufficientEntropy, MinCards(entropyBits), len(values))
+	}
+	var bits []byte
+	var deck []int
+	// Each card is numbered among the cards remaining in the deck, which makes the digits of
+	// a number in a mixed base of 52, 51, 50, ... uniformly distributed for a shuffled deck.
+	value, size := new(big.Int), big.NewInt(1)
+	for i, s := range values {
+		card, ok := parseCard(s)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q at card %d", ErrInvalidCard, s, i+1)
+		}
+		if slices.Contains(deck, card) {
+			bits = append(bits, uniformBits(value, size)...)
+			deck = deck[:0]
+			value, size = new(big.Int), big.NewInt(1)
+		}
+		digit := card
+		for _, drawn := range deck {
+			if drawn < card {
+				digit--
+			}
+		}
+		remaining := big.NewInt(int64(deckSize - len(deck)))
+		value.Mul(value, remaining).Add(value, big.NewInt(int64(digit)))
+		size.Mul(size, remaining)
+		deck = append(deck, card)
+	}
+	bits = append(bits, uniformBits(value, size)...)
+	return newPhysicalEntropy("cards", len(values), entropyBits, bits)
+}
+
+// maxInsufficientProbability is the largest probability that the minimum number of inputs supplies
+// less bits than requested, as the unbiased conversion rejects the largest values.
+const maxInsufficientProbability = 1.0 / (1 << 20)
+
+// MinDiceRolls returns the smallest number of rolls of a dice with the given number of sides that supplies
+// entropyBits with a probability of failure below 2^-20, such as 57 for 128 bits with a d6 and 35 with a d20.
+// The rolls hold entropyBits from fewer rolls, but then the unbiased conversion often supplies less.
+func MinDiceRolls(sides int, entropyBits int) int {
+	if sides < 2 {
+		return 0
+	}
+	target := new(big.Int).Lsh(big.NewInt(1), uint(entropyBits))
+	size := big.NewInt(1)
+	rolls := 0
+	// The conversion fails for the values in the blocks smaller than 2^entropyBits, the last
+	// size mod 2^entropyBits values, with a probability of (size mod 2^entropyBits) / size.
+	rest, margin := new(big.Int), new(big.Int)
+	for {
+		if size.Cmp(target) >= 0 {
+			rest.Mod(size, target)
+			if margin.Lsh(rest, 20).Cmp(size) <= 0 {
+				return rolls
+			}
+		}
+		size.Mul(size, big.NewInt(int64(sides)))
+		rolls++
+	}
+}
+
+// MinCoinFlips returns the number of coin flips that holds entropyBits, one per bit.
+func MinCoinFlips(entropyBits int) int {
+	return entropyBits
+}
+
+// MinCards returns the smallest number of cards of shuffled decks that supplies entropyBits
+// with a probability of failure below 2^-20, such as 28 for 128 bits and 62 for 256 bits.
+func MinCards(entropyBits int) int {
+	// A deck holds about 225.6 bits, after which the cards of the next deck start afresh.
+	target := new(big.Int).Lsh(big.NewInt(1), uint(entropyBits))
+	total := big.NewInt(1)
+	sizes := []*big.Int{big.NewInt(1)}
+	cards := 0
+	for total.Cmp(target) < 0 || insufficientProbability(sizes, entropyBits) > maxInsufficientProbability {
+		if cards > 0 && cards%deckSize == 0 {
+			sizes = append(sizes, big.NewInt(1))
+		}
+		remaining := big.NewInt(int64(deckSize - cards%deckSize))
+		sizes[len(sizes)-1].Mul(sizes[len(sizes)-1], remaining)
+		total.Mul(total, remaining)
+		cards++
+	}
+	return cards
+}
+
+// insufficientProbability returns the probability that values uniformly distributed in [0, size),
+// one for each size, supply less than entropyBits in total when converted with uniformBits.
+func insufficientProbability(sizes []*big.Int, entropyBits int) float64 {
+	// supplied[b] is the probability that the values so far supplied b bits, or at least entropyBits for the last.
+	supplied := make([]float64, entropyBits+1)
+	supplied[0] = 1
+	for _, size := range sizes {
+		next := make([]float64, entropyBits+1)
+		sizeFloat, _ := new(big.Float).SetInt(size).Float64()
+		// A value supplies k bits if it falls in the block of 2^k values of the bit k of size.
+		for k := range size.BitLen() {
+			if size.Bit(k) == 0 {
+				continue
+			}
+			p := math.Ldexp(1, k) / sizeFloat
+			for b, q := range supplied {
+				if q == 0 {
+					continue
+				}
+				next[min(b+k, entropyBits)] += q * p
+			}
+		}
+		supplied = next
+	}
+	probability := 0.0
+	for _, q := range supplied[:entropyBits] {
+		probability += q
+	}
+	return probability
+}
+
+func newPhysicalEntropy(source string, inputs int, entropyBits int, bits []byte) (*PhysicalEntropy, error) {
+	if len(bits) < entropyBits {
+		return nil, fmt.Errorf("%w: %d %s supplied %d of %d bits, add more", ErrInsufficientEntropy, inputs, source, len(bits), entropyBits)
+	}
+	entropy := make([]byte, entropyBits/8)
+	for i, bit := range bits[:entropyBits] {
+		entropy[i/8] |= bit << (7 - i%8)
+	}
+	return &PhysicalEntropy{
+		Entropy: entropy,
+		Source:  source,
+		Inputs:  inputs,
+		Bits:    len(bits),
+	}, nil
+}
+
+// uniformBits converts a value uniformly distributed in [0, size) to unbiased bits, most significant first,
+// as explained in PhysicalEntropy.
+func uniformBits(value, size *big.Int) []byte {
+	value, size = new(big.Int).Set(value), new(big.Int).Set(size)
+	for size.Cmp(big.NewInt(1)) > 0 {
+		k := size.BitLen() - 1
+		power := new(big.Int).Lsh(big.NewInt(1), uint(k))
+		if value.Cmp(power) < 0 {
+			bits := make([]byte, k)
+			for i := range bits {
+				bits[i] = byte(value.Bit(k - 1 - i))
+			}
+			return bits
+		}
+		value.Sub(value, power)
+		size.Sub(size, power)
+	}
+	return nil
+}
+
+// parseCard returns the number of a card from 0 to 51, ordered by suit, then by rank.
+func parseCard(s string) (int, bool) {
+	s = strings.ToUpper(s)
+	if len(s) < 2 {
+		return 0, false
+	}
+	rank := s[:len(s)-1]
+	if rank == "10" {
+		rank = "T"
+	}
+	rankIndex := slices.Index(cardRanks, rank)
+	suitIndex := strings.IndexByte("SHDC", s[len(s)-1])
+	if rankIndex < 0 || suitIndex < 0 {
+		return 0, false
+	}
+	return suitIndex*len(cardRanks) + rankIndex, true
+}
+
+// cardRanks are the ranks of the cards of a suit.
+var cardRanks = []string{"A", "2", "3", "4", "5", "6", "7", "8", "9", "T", "J", "Q", "K"}
+
+func isInputSeparator(r rune) bool {
+	return unicode.IsSpace(r) || r == ','
+}
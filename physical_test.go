@@ -0,0 +1,194 @@
+package bip39
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestEntropyFromDice(t *testing.T) {
+	tests := []struct {
+		rolls   string
+		sides   int
+		entropy string
+		bits    int
+		source  string
+	}{
+		{"324611513515211441215415126651554121523425153562153625146", 6, "7b02e55607de4f0fd6a6dfed1a73b67c", 147, "d6"},
+		{"19 7 12 4 18 3 19 2 20 7 16 18 14 11 15 19 15 12 10 8 6 8 3 19 10 17 16 11 15 10 5 13 1 20 9", 20, "d31924d258f0ef9521e98819996f2001", 148, "d20"},
+		{strings.Repeat("1 ", 57), 6, "00000000000000000000000000000000", 147, "d6"},
+	}
+	for _, test := range tests {
+		physical, err := EntropyFromDice(test.rolls, test.sides, 128)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(physical.Entropy) != test.entropy || physical.Bits != test.bits || physical.Source != test.source || physical.Inputs != MinDiceRolls(test.sides, 128) {
+			t.Fatal("invalid entropy", physical)
+		}
+	}
+
+	if _, err := EntropyFromDice(strings.Repeat("1", 56), 6, 128); !errors.Is(err, ErrInsufficientEntropy) {
+		t.Fatal("invalid error", err)
+	}
+	if _, err := EntropyFromDice(strings.Repeat("1", 56)+"7", 6, 128); !errors.Is(err, ErrInvalidDiceRoll) {
+		t.Fatal("invalid error", err)
+	}
+	if _, err := EntropyFromDice(strings.Repeat("1", 57), 1, 128); !errors.Is(err, ErrInvalidDiceRoll) {
+		t.Fatal("invalid error", err)
+	}
+	if _, err := EntropyFromDice(strings.Repeat("1", 57), 6, 100); err != ErrInvalidEntropy {
+		t.Fatal("invalid error", err)
+	}
+	// The largest value of 57 rolls, 6^57-1, is in the last block of a single value, it is rejected.
+	if _, err := EntropyFromDice(strings.Repeat("6", 57), 6, 128); !errors.Is(err, ErrInsufficientEntropy) {
+		t.Fatal("invalid error", err)
+	}
+}
+
+func TestEntropyFromCoins(t *testing.T) {
+	physical, err := EntropyFromCoins(strings.Repeat("HHHH TTTT ", 15)+"h,t,1,0,H,T,H,T", 128)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(physical.Entropy) != strings.Repeat("f0", 15)+"aa" || physical.Bits != 128 || physical.Inputs != 128 || physical.Source != "coins" {
+		t.Fatal("invalid entropy", physical)
+	}
+	if _, err = EntropyFromCoins(strings.Repeat("H", 127), 128); !errors.Is(err, ErrInsufficientEntropy) {
+		t.Fatal("invalid error", err)
+	}
+	if _, err = EntropyFromCoins(strings.Repeat("H", 127)+"X", 128); !errors.Is(err, ErrInvalidCoinFlip) {
+		t.Fatal("invalid error", err)
+	}
+}
+
+func TestEntropyFromCards(t *testing.T) {
+	deck := "8D 4H 4S 10C 7S 3D 8C 7H AH QH 5H KH 9D JC AS QS KS 4C 2H 6H QD 6C 2D JH 2S 3C KC AC 3H 9S 5D 6S 2C 4D 5C 10H 7C 8H JD 10D QC 3S 9C 6D 10S 9H JS AD 7D 8S 5S KD"
+	physical, err := EntropyFromCards(deck, 128)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(physical.Entropy) != "f5589cdcfd5b42b93272b93c0865b99d" || physical.Bits != 225 || physical.Inputs != 52 || physical.Source != "cards" {
+		t.Fatal("invalid entropy", physical)
+	}
+	// "T" and "10" are the same rank, and the case does not matter.
+	physical2, err := EntropyFromCards(strings.ToLower(strings.ReplaceAll(deck, "10", "T")), 128)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(physical.Entropy) != string(physical2.Entropy) {
+		t.Fatal("invalid entropy")
+	}
+
+	if _, err = EntropyFromCards(deck, 256); !errors.Is(err, ErrInsufficientEntropy) {
+		t.Fatal("invalid error", err)
+	}
+	// A second deck starts with a card of the first deck.
+	physical, err = EntropyFromCards(deck+" AS 2S 3S 4S 5S 6S 7S 8S 9S TS", 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if physical.Inputs != 62 || physical.Bits < 256 || len(physical.Entropy) != 32 {
+		t.Fatal("invalid entropy", physical)
+	}
+
+	if _, err = EntropyFromCards(strings.Replace(deck, "KD", "KX", 1), 128); !errors.Is(err, ErrInvalidCard) {
+		t.Fatal("invalid error", err)
+	}
+	if _, err = EntropyFromCards(strings.Replace(deck, "KD", "23D", 1), 128); !errors.Is(err, ErrInvalidCard) {
+		t.Fatal("invalid error", err)
+	}
+}
+
+func TestMinInputs(t *testing.T) {
+	if MinDiceRolls(6, 128) != 57 || MinDiceRolls(6, 256) != 106 || MinDiceRolls(20, 128) != 35 || MinDiceRolls(2, 160) != 160 {
+		t.Fatal("invalid minimum dice rolls")
+	}
+	if MinCoinFlips(192) != 192 {
+		t.Fatal("invalid minimum coin flips")
+	}
+	if MinCards(128) != 28 || MinCards(256) != 62 {
+		t.Fatal("invalid minimum cards", MinCards(128), MinCards(256))
+	}
+}
+
+func TestInsufficientProbability(t *testing.T) {
+	// Holding entropyBits is not enough: 50 rolls of a d6 and 30 of a d20 hold 128 bits,
+	// but fail to supply them 16% and 5% of the time.
+	d6, d20 := new(big.Int).Exp(big.NewInt(6), big.NewInt(50), nil), new(big.Int).Exp(big.NewInt(20), big.NewInt(30), nil)
+	if p := insufficientProbability([]*big.Int{d6}, 128); p < 0.15 || p > 0.16 {
+		t.Fatal("invalid probability", p)
+	}
+	if p := insufficientProbability([]*big.Int{d20}, 128); p < 0.04 || p > 0.05 {
+		t.Fatal("invalid probability", p)
+	}
+
+	// The failure rate at the minimum is measured on random inputs too.
+	random := rand.New(rand.NewPCG(1, 2))
+	const trials = 1000
+	for _, sides := range []int{6, 10, 20} {
+		for _, entropyBits := range validEntropyBits {
+			rolls := make([]string, MinDiceRolls(sides, entropyBits))
+			for range trials {
+				for i := range rolls {
+					rolls[i] = strconv.Itoa(random.IntN(sides) + 1)
+				}
+				if _, err := EntropyFromDice(strings.Join(rolls, " "), sides, entropyBits); err != nil {
+					t.Fatalf("d%d, %d bits: %v", sides, entropyBits, err)
+				}
+			}
+		}
+	}
+	deck := make([]string, 0, deckSize)
+	for _, suit := range "SHDC" {
+		for _, rank := range cardRanks {
+			deck = append(deck, rank+string(suit))
+		}
+	}
+	for _, entropyBits := range validEntropyBits {
+		cards := MinCards(entropyBits)
+		for range trials {
+			var shuffled []string
+			for len(shuffled) < cards {
+				random.Shuffle(len(deck), func(i, j int) { deck[i], deck[j] = deck[j], deck[i] })
+				shuffled = append(shuffled, deck...)
+			}
+			if _, err := EntropyFromCards(strings.Join(shuffled[:cards], " "), entropyBits); err != nil {
+				t.Fatalf("cards, %d bits: %v", entropyBits, err)
+			}
+		}
+	}
+
+	// The minimums are the smallest inputs below the failure rate.
+	for _, entropyBits := range validEntropyBits {
+		for _, sides := range []int{3, 6, 12, 20, 100} {
+			rolls := MinDiceRolls(sides, entropyBits)
+			size := new(big.Int).Exp(big.NewInt(int64(sides)), big.NewInt(int64(rolls)), nil)
+			smaller := new(big.Int).Div(size, big.NewInt(int64(sides)))
+			if insufficientProbability([]*big.Int{size}, entropyBits) > maxInsufficientProbability ||
+				insufficientProbability([]*big.Int{smaller}, entropyBits) <= maxInsufficientProbability {
+				t.Fatalf("d%d, %d bits: invalid minimum %d", sides, entropyBits, rolls)
+			}
+		}
+	}
+}
+
+func TestUniformBits(t *testing.T) {
+	// Every value converts to bits, or is rejected, and the bits of the same length are equally likely.
+	for _, size := range []int64{2, 6, 20, 52, 1000} {
+		counts := make(map[string]int)
+		for value := range size {
+			bits := uniformBits(big.NewInt(value), big.NewInt(size))
+			counts[string(bits)]++
+		}
+		for bits, count := range counts {
+			if count != 1 {
+				t.Fatalf("size %d: %v appears %d times", size, []byte(bits), count)
+			}
+		}
+	}
+}
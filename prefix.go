@@ -0,0 +1,132 @@
+package bip39
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrAmbiguousPrefix = errors.New("ambiguous prefix")
+	ErrUnknownPrefix   = errors.New("unknown prefix")
+)
+
+// PrefixError reports a word of a mnemonic that is neither a word of the wordlist nor the unique prefix of one.
+type PrefixError struct {
+	// Position is the index of the word in the mnemonic, starting at 0.
+	Position int
+	// Prefix is the word as found in the mnemonic, in NFKD form.
+	Prefix string
+	// Candidates are the words of the wordlist starting with Prefix. It is empty if the prefix is unknown.
+	Candidates []string
+}
+
+func (e *PrefixError) Error() string {
+	if len(e.Candidates) == 0 {
+		return fmt.Sprintf("%s %q at word %d", ErrUnknownPrefix, e.Prefix, e.Position+1)
+	}
+	return fmt.Sprintf("%s %q at word %d: %s", ErrAmbiguousPrefix, e.Prefix, e.Position+1, strings.Join(e.Candidates, ", "))
+}
+
+// Unwrap returns ErrInvalidMnemonic and either ErrAmbiguousPrefix or ErrUnknownPrefix, for use with errors.Is.
+func (e *PrefixError) Unwrap() []error {
+	if len(e.Candidates) == 0 {
+		return []error{ErrInvalidMnemonic, ErrUnknownPrefix}
+	}
+	return []error{ErrInvalidMnemonic, ErrAmbiguousPrefix}
+}
+
+// PrefixErrors reports every word of a mnemonic that could not be expanded, in the order of the mnemonic.
+//
+// Example:
+//
+//	var prefixErrs bip39.PrefixErrors
+//	if errors.As(err, &prefixErrs) {
+//		for _, e := range prefixErrs {
+//			fmt.Println(e.Position, e.Prefix, e.Candidates)
+//		}
+//	}
+type PrefixErrors []*PrefixError
+
+func (e PrefixErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e PrefixErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// UniquePrefixLength returns the number of leading characters that identify every word of the language,
+// such as 4 for English and 1 for Chinese. Words shorter than that are identified by the whole word.
+// It returns 0 if the language is not supported.
+func (l Language) UniquePrefixLength() int {
+	data, ok := registry().language(l)
+	if !ok {
+		return 0
+	}
+	return data.prefixLength
+}
+
+// ExpandMnemonic replaces the prefixes in the mnemonic by the words of the wordlist they identify,
+// for instance "aban aban abou" by "abandon abandon about" in English.
+// Full words are kept as they are, even if they are also the prefix of other words.
+// The mnemonic is normalized to NFKD form and its checksum is not verified.
+//
+// If some words cannot be expanded, the error is a PrefixErrors reporting all of them.
+func (m *Mnemonic) ExpandMnemonic(mnemonic string) (string, error) {
+	words, _ := SplitMnemonic(mnemonic)
+	words, err := expandPrefixes(m.wordMap, m.index, words)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(words, m.delimiter), nil
+}
+
+// expandPrefixes returns words with every prefix replaced by the word it identifies in the language.
+func expandPrefixes(wordsMap map[string]int, index *wordIndex, words []string) ([]string, error) {
+	expanded := make([]string, len(words))
+	var errs PrefixErrors
+	for i, word := range words {
+		candidates := lookupPrefix(wordsMap, index, word)
+		if len(candidates) == 1 {
+			expanded[i] = candidates[0]
+			continue
+		}
+		errs = append(errs, &PrefixError{Position: i, Prefix: word, Candidates: candidates})
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return expanded, nil
+}
+
+// lookupPrefix returns the words of the language that start with prefix,
+// or only prefix itself if it is a whole word.
+// A prefix does not match inside a character, so "e" matches "essay" but not "élève".
+// A prefix matching no word is looked up again ignoring case, and accents in Latin wordlists,
+// so that "ABAN" matches "abandon" and "elev" matches "élève", as stamped on metal plates.
+func lookupPrefix(wordsMap map[string]int, index *wordIndex, prefix string) []string {
+	if _, ok := wordsMap[prefix]; ok {
+		return []string{prefix}
+	}
+	if prefix == "" {
+		return nil
+	}
+	if words := index.completions(prefix); words != nil {
+		return words
+	}
+	return index.foldedCompletions(prefix)
+}
+
+// hasPrefix reports whether prefix is a word of the language or the prefix of at least one.
+func hasPrefix(wordsMap map[string]int, index *wordIndex, prefix string) bool {
+	return len(lookupPrefix(wordsMap, index, prefix)) > 0
+}
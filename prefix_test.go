@@ -0,0 +1,168 @@
+package bip39
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestUniquePrefixLength(t *testing.T) {
+	if English.UniquePrefixLength() != 4 || ChineseSimplified.UniquePrefixLength() != 1 || Language(100).UniquePrefixLength() != 0 {
+		t.Fatal("invalid unique prefix length")
+	}
+	// Every word is identified by its unique prefix.
+	for lang, data := range registry().all() {
+		m, err := NewMnemonic(WithLanguage(lang))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, word := range data.words {
+			prefix := string([]rune(norm.NFC.String(word))[:min(lang.UniquePrefixLength(), len([]rune(norm.NFC.String(word))))])
+			expanded, err := m.ExpandMnemonic(prefix)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if expanded != normalizeString(word) {
+				t.Fatalf("%s: %s expanded to %s", lang, prefix, expanded)
+			}
+		}
+	}
+}
+
+func TestPrefixMnemonic(t *testing.T) {
+	m, err := NewMnemonic(WithPrefixes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	entropy, err := m.EntropyFromMnemonic("lega winn than year wave saus wort usef lega winn than yell")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(entropy, []byte{0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f}) {
+		t.Fatal("invalid entropy")
+	}
+	// Full words are accepted too, even if they are the prefix of other words.
+	expanded, err := m.ExpandMnemonic("act acti abou")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expanded != "act action about" {
+		t.Fatal("invalid expansion")
+	}
+
+	_, err = m.EntropyFromMnemonic("lega ab than year wave saus wort usef lega xyzw than yell")
+	var prefixErrs PrefixErrors
+	if !errors.As(err, &prefixErrs) || len(prefixErrs) != 2 {
+		t.Fatal("invalid error", err)
+	}
+	if prefixErrs[0].Position != 1 || prefixErrs[0].Prefix != "ab" || !slices.Contains(prefixErrs[0].Candidates, "abandon") || !errors.Is(prefixErrs[0], ErrAmbiguousPrefix) {
+		t.Fatal("invalid ambiguous prefix error", prefixErrs[0])
+	}
+	if prefixErrs[1].Position != 9 || len(prefixErrs[1].Candidates) != 0 || !errors.Is(prefixErrs[1], ErrUnknownPrefix) {
+		t.Fatal("invalid unknown prefix error", prefixErrs[1])
+	}
+	if !errors.Is(err, ErrInvalidMnemonic) {
+		t.Fatal("prefix errors must be invalid mnemonic errors")
+	}
+
+	// Prefixes are rejected by default.
+	m, err = NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.EntropyFromMnemonic("lega winn than year wave saus wort usef lega winn than yell"); !errors.Is(err, ErrInvalidMnemonic) {
+		t.Fatal("prefixes must be rejected by default")
+	}
+}
+
+func TestPrefixCharacters(t *testing.T) {
+	// A prefix does not match a word with a different character at the same position.
+	m, err := NewMnemonic(WithLanguage(French), WithPrefixes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expanded, err := m.ExpandMnemonic("élè")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expanded != normalizeString("élève") {
+		t.Fatal("invalid expansion")
+	}
+	// Without accents, a prefix matches the words with them, as no word starts with it.
+	if _, err = m.ExpandMnemonic("ele"); !errors.Is(err, ErrAmbiguousPrefix) {
+		t.Fatal("invalid error", err)
+	}
+	if expanded, err = m.ExpandMnemonic("ELEV"); err != nil || expanded != normalizeString("élève") {
+		t.Fatal("invalid expansion", expanded, err)
+	}
+
+	m, err = NewMnemonic(WithLanguage(Japanese), WithPrefixes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expanded, err = m.ExpandMnemonic("おさえ　けむり　がぞ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expanded != NormalizeMnemonic("おさえる　けむり　がぞう") {
+		t.Fatal("invalid expansion", expanded)
+	}
+}
+
+func TestPrefixPlates(t *testing.T) {
+	// Metal plates are stamped in upper case, without accents.
+	m, err := NewMnemonic(WithPrefixes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	entropy, err := m.EntropyFromMnemonic("LEGA WINN THAN YEAR WAVE SAUS WORT USEF LEGA WINN THAN YELL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(entropy, bytes.Repeat([]byte{0x7f}, 16)) {
+		t.Fatal("invalid entropy")
+	}
+	if languages, ok := DetectLanguage("ABAN ABAN ABAN ABAN ABAN ABAN ABAN ABAN ABAN ABAN ABAN ABOU", WithPrefixDetection()); !ok || !slices.Contains(languages, English) {
+		t.Fatal("invalid language", languages)
+	}
+
+	for lang, data := range registry().all() {
+		if data.index.folded == nil {
+			continue
+		}
+		m, err := NewMnemonic(WithLanguage(lang), WithPrefixes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, word := range data.words {
+			runes := []rune(strings.ToUpper(foldWord(word)))
+			for _, plate := range []string{string(runes[:min(lang.UniquePrefixLength(), len(runes))]), string(runes)} {
+				expanded, err := m.ExpandMnemonic(plate)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if expanded != normalizeString(word) {
+					t.Fatalf("%s: %s expanded to %s", lang, plate, expanded)
+				}
+			}
+		}
+	}
+	if data, _ := registry().language(Japanese); data.index.folded != nil {
+		t.Fatal("unexpected folded japanese words")
+	}
+}
+
+func TestDetectLanguagePrefixes(t *testing.T) {
+	mnemonic := "lega winn than year wave saus wort usef lega winn than yell"
+	if _, ok := DetectLanguage(mnemonic); ok {
+		t.Fatal("prefixes must not be detected by default")
+	}
+	languages, ok := DetectLanguage(mnemonic, WithPrefixDetection())
+	if !ok || !slices.Equal(languages, []Language{English}) {
+		t.Fatal("invalid language", languages)
+	}
+}
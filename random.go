@@ -0,0 +1,62 @@
+package bip39
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/chacha20"
+)
+
+// RandomError reports a failure of the source of randomness of GenerateMnemonic,
+// either an error of the reader or a short read.
+type RandomError struct {
+	// Read is the number of bytes read before the failure.
+	Read int
+	// Size is the number of bytes requested.
+	Size int
+	// Err is the error of the reader, io.ErrUnexpectedEOF or io.EOF for a short read.
+	Err error
+}
+
+func (e *RandomError) Error() string {
+	return fmt.Sprintf("reading random: %d of %d bytes: %v", e.Read, e.Size, e.Err)
+}
+
+func (e *RandomError) Unwrap() error {
+	return e.Err
+}
+
+// readRandom fills b from random, or returns a RandomError.
+func readRandom(random io.Reader, b []byte) error {
+	n, err := io.ReadFull(random, b)
+	if err != nil {
+		return &RandomError{Read: n, Size: len(b), Err: err}
+	}
+	return nil
+}
+
+// NewDeterministicRandom returns a source of randomness that always produces the same bytes for the same seed,
+// for reproducible tests and fixtures with WithRandom() option.
+// The bytes are the ChaCha20 key stream with the SHA256 hash of the seed as key and a zero nonce.
+//
+// The mnemonics it generates are only as secret as the seed: never use it for real wallets.
+func NewDeterministicRandom(seed []byte) io.Reader {
+	key := sha256.Sum256(seed)
+	cipher, err := chacha20.NewUnauthenticatedCipher(key[:], make([]byte, chacha20.NonceSize))
+	if err != nil {
+		// The key and nonce sizes are valid.
+		panic(err)
+	}
+	return &deterministicRandom{cipher: cipher}
+}
+
+type deterministicRandom struct {
+	cipher *chacha20.Cipher
+}
+
+func (r *deterministicRandom) Read(b []byte) (int, error) {
+	clear(b)
+	r.cipher.XORKeyStream(b, b)
+	return len(b), nil
+}
@@ -0,0 +1,75 @@
+package bip39
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+func TestWithRandom(t *testing.T) {
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mnemonic, err := m.GenerateMnemonic(WithRandom(bytes.NewReader(bytes.Repeat([]byte{0x7f}, 16))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mnemonic != "legal winner thank year wave sausage worth useful legal winner thank yellow" {
+		t.Fatal("invalid mnemonic", mnemonic)
+	}
+
+	// Short reads and reader errors are reported as RandomError.
+	_, err = m.GenerateMnemonic(WithRandom(bytes.NewReader(make([]byte, 10))))
+	var randomErr *RandomError
+	if !errors.As(err, &randomErr) || randomErr.Read != 10 || randomErr.Size != 16 || !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatal("invalid error", err)
+	}
+	readErr := errors.New("device unplugged")
+	_, err = m.GenerateMnemonic(WithEntropyBits(256), WithRandom(iotest.ErrReader(readErr)))
+	if !errors.As(err, &randomErr) || randomErr.Read != 0 || randomErr.Size != 32 || !errors.Is(err, readErr) {
+		t.Fatal("invalid error", err)
+	}
+	// Readers returning fewer bytes than requested are read until full.
+	if _, err = m.GenerateMnemonic(WithRandom(iotest.OneByteReader(bytes.NewReader(make([]byte, 16))))); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeterministicRandom(t *testing.T) {
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	generate := func(seed string, bits int) string {
+		mnemonic, err := m.GenerateMnemonic(WithEntropyBits(bits), WithRandom(NewDeterministicRandom([]byte(seed))))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return mnemonic
+	}
+	if generate("fixture", 128) != generate("fixture", 128) || generate("fixture", 128) == generate("fixture2", 128) {
+		t.Fatal("invalid deterministic random")
+	}
+
+	// The stream does not depend on how it is read.
+	a, b := NewDeterministicRandom([]byte("fixture")), NewDeterministicRandom([]byte("fixture"))
+	full := make([]byte, 100)
+	if _, err = io.ReadFull(a, full); err != nil {
+		t.Fatal(err)
+	}
+	parts := make([]byte, 100)
+	if _, err = io.ReadFull(iotest.HalfReader(b), parts); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(full, parts) {
+		t.Fatal("invalid deterministic random")
+	}
+
+	// ChaCha20 key stream of SHA256("fixture"): 0d92e05299d474f25ec7fe4d4b42bf1b...
+	if generate("fixture", 128) != "assault novel believe crisp elder junior kitchen zone essence foam quit damage" {
+		t.Fatal("invalid mnemonic", generate("fixture", 128))
+	}
+}
@@ -0,0 +1,230 @@
+package bip39
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// defaultPlaceholder is the default word marking a missing word in a mnemonic.
+	defaultPlaceholder = "?"
+	// maxMissingWords is the largest number of missing words RecoverMissingWords searches for.
+	maxMissingWords = 4
+	// recoverChunkSize is the number of combinations a worker checks between two progress reports.
+	recoverChunkSize = 1 << 12
+)
+
+var ErrTooManyMissingWords = errors.New("too many missing words")
+
+// RecoverMissingWords returns every mnemonic with a valid checksum that completes a mnemonic with missing words.
+//
+// A missing word at a known position is marked with a placeholder, "?" by default.
+// Missing words at unknown positions are searched for when the mnemonic has fewer words than expected,
+// by default the smallest valid number of words that is not less than the words of the mnemonic.
+// Use WithPlaceholder() and WithWordsCount() options to change them. At most 4 words may be missing.
+// With WithPrefixes() option, the known words may be unique prefixes.
+//
+// The combinations are checked concurrently by GOMAXPROCS goroutines, see WithWorkers() option,
+// and WithProgress() option reports how many were checked.
+// The mnemonics are returned in NFKD form, without duplicates, in the order they were enumerated:
+// by positions of the unknown missing words, then by the words filling the missing positions.
+// If ctx is done before the end, the mnemonics found so far are returned together with ctx.Err().
+//
+// Example:
+//
+//	mnemonics, err := m.RecoverMissingWords(ctx, "legal winner thank year wave ? worth useful legal winner thank yellow")
+func (m *Mnemonic) RecoverMissingWords(ctx context.Context, mnemonic string, opts ...RecoverOption) ([]string, error) {
+	options := &RecoverOptions{
+		placeholder: defaultPlaceholder,
+		workers:     runtime.GOMAXPROCS(0),
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	words, _ := SplitMnemonic(mnemonic)
+	wordsCount := options.wordsCount
+	if wordsCount == 0 {
+		for _, size := range validWordsSizes {
+			if size >= len(words) {
+				wordsCount = size
+				break
+			}
+		}
+	}
+	if !isValidWordsSize(wordsCount) || len(words) > wordsCount {
+		return nil, ErrInvalidNumberWords
+	}
+
+	// indexes are the word indexes of the mnemonic, -1 for placeholders.
+	placeholder := normalizeString(options.placeholder)
+	indexes := make([]int, len(words))
+	missing := wordsCount - len(words)
+	for i, word := range words {
+		if word == placeholder {
+			indexes[i] = -1
+			missing++
+			continue
+		}
+		index, ok := m.wordMap[word]
+		if !ok && m.allowPrefixes {
+			if candidates := lookupPrefix(m.wordMap, m.index, word); len(candidates) == 1 {
+				index, ok = m.wordMap[candidates[0]], true
+			}
+		}
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown word %q at word %d", ErrInvalidMnemonic, word, i+1)
+		}
+		indexes[i] = index
+	}
+	if missing > maxMissingWords {
+		return nil, fmt.Errorf("%w: %d", ErrTooManyMissingWords, missing)
+	}
+
+	r := &recovery{
+		wordList:      m.index.wordlist,
+		delimiter:     m.delimiter,
+		templates:     missingTemplates(indexes, wordsCount),
+		missing:       missing,
+		combinations:  uint64(1) << (bitsPerWord * missing),
+		progress:      options.progress,
+		foundByChunks: make(map[uint64][]string),
+	}
+	r.total = uint64(len(r.templates)) * r.combinations
+	r.run(ctx, max(options.workers, 1))
+	if r.done < r.total {
+		return r.results(), ctx.Err()
+	}
+	return r.results(), nil
+}
+
+// recovery is the state of a RecoverMissingWords search shared by the workers.
+type recovery struct {
+	wordList  []string
+	delimiter string
+	// templates are the word indexes of the complete mnemonics for each position of the unknown missing words,
+	// with -1 at every missing position.
+	templates [][]int
+	// missing is the number of missing words.
+	missing int
+	// combinations is the number of ways to fill the missing positions of a template.
+	combinations uint64
+	// total is the number of combinations of all templates.
+	total    uint64
+	progress func(done, total uint64)
+
+	next atomic.Uint64 // next chunk to check
+
+	mu            sync.Mutex
+	done          uint64 // combinations checked
+	foundByChunks map[uint64][]string
+}
+
+func (r *recovery) run(ctx context.Context, workers int) {
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.work(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+// work checks chunks of combinations until all are checked or ctx is done.
+func (r *recovery) work(ctx context.Context) {
+	indexes := make([]int, len(r.templates[0]))
+	var buf [maxEntropyWithChecksumSize]byte
+	for ctx.Err() == nil {
+		chunk := r.next.Add(1) - 1
+		start := chunk * recoverChunkSize
+		if start >= r.total {
+			return
+		}
+		end := min(start+recoverChunkSize, r.total)
+		var found []string
+		for i := start; i < end; i++ {
+			template := r.templates[i/r.combinations]
+			combination := i % r.combinations
+			// The missing words are the base 2048 digits of the combination, the first missing word most significant.
+			shift := bitsPerWord * r.missing
+			for j, index := range template {
+				if index < 0 {
+					shift -= bitsPerWord
+					index = int(combination>>shift) & (1<<bitsPerWord - 1)
+				}
+				indexes[j] = index
+			}
+			if isChecksumValid(indexes, buf[:]) {
+				found = append(found, r.mnemonic(indexes))
+			}
+		}
+		r.mu.Lock()
+		if len(found) > 0 {
+			r.foundByChunks[chunk] = found
+		}
+		r.done += end - start
+		if r.progress != nil {
+			r.progress(r.done, r.total)
+		}
+		r.mu.Unlock()
+	}
+}
+
+func (r *recovery) mnemonic(indexes []int) string {
+	var b []byte
+	for i, index := range indexes {
+		if i > 0 {
+			b = append(b, r.delimiter...)
+		}
+		b = append(b, r.wordList[index]...)
+	}
+	return string(b)
+}
+
+// results returns the mnemonics found in the order of the chunks, without duplicates.
+func (r *recovery) results() []string {
+	var results []string
+	seen := make(map[string]bool)
+	for chunk := range (r.total + recoverChunkSize - 1) / recoverChunkSize {
+		for _, mnemonic := range r.foundByChunks[chunk] {
+			if !seen[mnemonic] {
+				seen[mnemonic] = true
+				results = append(results, mnemonic)
+			}
+		}
+	}
+	return results
+}
+
+// missingTemplates returns the word indexes of the complete mnemonics of wordsCount words,
+// for every way to insert the words missing at unknown positions among indexes, in lexicographic order of their positions.
+// The missing positions are -1.
+func missingTemplates(indexes []int, wordsCount int) [][]int {
+	var templates [][]int
+	template := make([]int, 0, wordsCount)
+	var insert func(rest []int, unknown int)
+	insert = func(rest []int, unknown int) {
+		if len(template) == wordsCount {
+			templates = append(templates, append([]int(nil), template...))
+			return
+		}
+		// Either a word missing at an unknown position comes first, or the next word of the mnemonic.
+		if unknown > 0 {
+			template = append(template, -1)
+			insert(rest, unknown-1)
+			template = template[:len(template)-1]
+		}
+		if len(rest) > 0 {
+			template = append(template, rest[0])
+			insert(rest[1:], unknown)
+			template = template[:len(template)-1]
+		}
+	}
+	insert(indexes, wordsCount-len(indexes))
+	return templates
+}
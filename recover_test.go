@@ -0,0 +1,125 @@
+package bip39
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRecoverMissingWords(t *testing.T) {
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	const mnemonic = "legal winner thank year wave sausage worth useful legal winner thank yellow"
+
+	// A missing word at a known position: about one of 16 words completes the 4 bits checksum.
+	mnemonics, err := m.RecoverMissingWords(context.Background(), "legal winner thank year wave ? worth useful legal winner thank yellow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected []string
+	for _, word := range m.wordList {
+		candidate := strings.Replace(mnemonic, "sausage", word, 1)
+		if _, err := m.EntropyFromMnemonic(candidate); err == nil {
+			expected = append(expected, candidate)
+		}
+	}
+	if !slices.Equal(mnemonics, expected) || !slices.Contains(mnemonics, mnemonic) {
+		t.Fatal("invalid mnemonics", len(mnemonics), len(expected))
+	}
+
+	// A missing word at an unknown position.
+	var progress []uint64
+	mnemonics, err = m.RecoverMissingWords(context.Background(), "legal winner thank year wave worth useful legal winner thank yellow",
+		WithWorkers(3), WithProgress(func(done, total uint64) {
+			if total != 12*2048 {
+				t.Error("invalid total", total)
+			}
+			progress = append(progress, done)
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Contains(mnemonics, mnemonic) || len(slices.Compact(slices.Sorted(slices.Values(mnemonics)))) != len(mnemonics) {
+		t.Fatal("invalid mnemonics", len(mnemonics))
+	}
+	if !slices.IsSorted(progress) || progress[len(progress)-1] != 12*2048 {
+		t.Fatal("invalid progress", progress)
+	}
+
+	// A custom placeholder in a 24 words mnemonic with prefixes: about one of 256 words completes the 8 bits checksum.
+	m, err = NewMnemonic(WithPrefixes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	mnemonics, err = m.RecoverMissingWords(context.Background(), strings.Repeat("aban ", 22)+"_ art", WithPlaceholder("_"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Contains(mnemonics, strings.Repeat("abandon ", 23)+"art") {
+		t.Fatal("invalid mnemonics", mnemonics)
+	}
+	for _, recovered := range mnemonics {
+		if _, err := m.EntropyFromMnemonic(recovered); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRecoverMissingWordsErrors(t *testing.T) {
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.RecoverMissingWords(context.Background(), "? ? ? ? ? ? ? ? ? ? ? ?"); !errors.Is(err, ErrTooManyMissingWords) {
+		t.Fatal("invalid error", err)
+	}
+	if _, err = m.RecoverMissingWords(context.Background(), "legal winner thank year wave ? worth useful legal winner thank yelow"); !errors.Is(err, ErrInvalidMnemonic) {
+		t.Fatal("invalid error", err)
+	}
+	if _, err = m.RecoverMissingWords(context.Background(), "legal winner ?", WithWordsCount(13)); !errors.Is(err, ErrInvalidNumberWords) {
+		t.Fatal("invalid error", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = m.RecoverMissingWords(ctx, "legal winner thank year wave ? worth useful legal winner thank ?"); !errors.Is(err, context.Canceled) {
+		t.Fatal("invalid error", err)
+	}
+}
+
+func TestIsChecksumValid(t *testing.T) {
+	for lang := range registry().all() {
+		m, err := NewMnemonic(WithLanguage(lang))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, bits := range validEntropyBits {
+			mnemonic, err := m.GenerateMnemonic(WithEntropyBits(bits))
+			if err != nil {
+				t.Fatal(err)
+			}
+			words, _ := SplitMnemonic(mnemonic)
+			indexes := make([]int, len(words))
+			for i, word := range words {
+				indexes[i] = m.wordMap[word]
+			}
+			var buf [maxEntropyWithChecksumSize]byte
+			if !isChecksumValid(indexes, buf[:]) {
+				t.Fatal("invalid checksum", mnemonic)
+			}
+			// Change the last word, which holds the checksum.
+			for i := range 16 {
+				indexes[len(indexes)-1] ^= i
+				words[len(words)-1] = m.wordList[indexes[len(indexes)-1]]
+				_, err := m.entropyFromWords(words)
+				if isChecksumValid(indexes, buf[:]) != (err == nil) {
+					t.Fatal("invalid checksum", words)
+				}
+			}
+		}
+	}
+}
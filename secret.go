@@ -0,0 +1,158 @@
+package bip39
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+)
+
+var ErrSecretMarshal = errors.New("secret not marshaled")
+
+// redacted is what the secrets print instead of their value.
+const redacted = "[REDACTED]"
+
+// secret is the redacted value shared by SecretMnemonic, SecretSeed and SecretPassphrase.
+// Its methods are promoted to them, so that fmt, slog and the encoders find them.
+type secret struct {
+	// name is the name of the type embedding the secret, for GoString.
+	name string
+	// value returns the value of the secret. It is a func, which fmt prints as an address, as fmt cannot call
+	// the methods of a secret in an unexported field and prints its fields instead, even with a pointer.
+	value func() []byte
+	// binary marks raw bytes such as seeds, which are marshaled in hex, as they are not valid UTF-8 text.
+	binary          bool
+	revealOnMarshal bool
+}
+
+func newSecret(name string, value []byte, opts []SecretOption) secret {
+	options := &SecretOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return secret{
+		name:            name,
+		value:           func() []byte { return value },
+		revealOnMarshal: options.revealOnMarshal,
+	}
+}
+
+// bytes returns the value of the secret, nil for the zero secret.
+func (s secret) bytes() []byte {
+	if s.value == nil {
+		return nil
+	}
+	return s.value()
+}
+
+// String returns "[REDACTED]".
+func (s secret) String() string {
+	return redacted
+}
+
+// GoString returns the type of the secret with "[REDACTED]", such as bip39.SecretMnemonic("[REDACTED]"), for %#v.
+func (s secret) GoString() string {
+	return fmt.Sprintf("bip39.%s(%q)", s.name, redacted)
+}
+
+// Format prints "[REDACTED]" whatever the verb and the flags, so that %x or %q print nothing of the value either.
+func (s secret) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprint(f, s.GoString())
+	case verb == 'q':
+		fmt.Fprint(f, strconv.Quote(redacted))
+	default:
+		fmt.Fprint(f, redacted)
+	}
+}
+
+// LogValue implements slog.LogValuer, so that slog logs "[REDACTED]".
+func (s secret) LogValue() slog.Value {
+	return slog.StringValue(redacted)
+}
+
+// MarshalText implements encoding.TextMarshaler, used by json.Marshal among others.
+// It fails with ErrSecretMarshal, unless the secret was created with WithRevealOnMarshal() option.
+// Seeds and other raw bytes are marshaled in hex.
+func (s secret) MarshalText() ([]byte, error) {
+	if !s.revealOnMarshal {
+		return nil, fmt.Errorf("%w: %s", ErrSecretMarshal, s.name)
+	}
+	if s.binary {
+		return hex.AppendEncode(nil, s.bytes()), nil
+	}
+	return slices.Clone(s.bytes()), nil
+}
+
+// newBinarySecret returns the secret holding the raw bytes value.
+func newBinarySecret(name string, value []byte, opts []SecretOption) secret {
+	s := newSecret(name, value, opts)
+	s.binary = true
+	return s
+}
+
+// SecretMnemonic holds a mnemonic that does not leak into logs: fmt, slog and %#v print "[REDACTED]",
+// and json.Marshal fails unless WithRevealOnMarshal() option is used. Reveal returns the mnemonic.
+type SecretMnemonic struct {
+	secret
+}
+
+// NewSecretMnemonic returns the secret holding mnemonic.
+func NewSecretMnemonic(mnemonic string, opts ...SecretOption) SecretMnemonic {
+	return SecretMnemonic{newSecret("SecretMnemonic", []byte(mnemonic), opts)}
+}
+
+// Reveal returns the mnemonic.
+func (s SecretMnemonic) Reveal() string {
+	return string(s.bytes())
+}
+
+// Seed returns the seed of the mnemonic and the passphrase, as NewSeed does.
+func (s SecretMnemonic) Seed(passphrase SecretPassphrase) SecretSeed {
+	return SecretSeed{newBinarySecret("SecretSeed", NewSeed(s.Reveal(), WithPassphrase(passphrase.Reveal())), nil)}
+}
+
+// SecretSeed holds a seed that does not leak into logs, as SecretMnemonic does. Reveal returns the seed.
+// With WithRevealOnMarshal() option, it is marshaled in hex.
+type SecretSeed struct {
+	secret
+}
+
+// NewSecretSeed returns the secret holding a copy of seed.
+func NewSecretSeed(seed []byte, opts ...SecretOption) SecretSeed {
+	return SecretSeed{newBinarySecret("SecretSeed", slices.Clone(seed), opts)}
+}
+
+// Reveal returns a copy of the seed.
+func (s SecretSeed) Reveal() []byte {
+	return slices.Clone(s.bytes())
+}
+
+// SecretPassphrase holds a BIP39 passphrase that does not leak into logs, as SecretMnemonic does.
+// Reveal returns the passphrase.
+type SecretPassphrase struct {
+	secret
+}
+
+// NewSecretPassphrase returns the secret holding passphrase.
+func NewSecretPassphrase(passphrase string, opts ...SecretOption) SecretPassphrase {
+	return SecretPassphrase{newSecret("SecretPassphrase", []byte(passphrase), opts)}
+}
+
+// Reveal returns the passphrase.
+func (s SecretPassphrase) Reveal() string {
+	return string(s.bytes())
+}
+
+// GenerateSecretMnemonic generates a new mnemonic as GenerateMnemonic does, and returns it as a SecretMnemonic,
+// so that it is never a plain string until it is revealed.
+func (m *Mnemonic) GenerateSecretMnemonic(opts ...GenerateMnemonicOption) (SecretMnemonic, error) {
+	mnemonic, err := m.GenerateMnemonic(opts...)
+	if err != nil {
+		return SecretMnemonic{}, err
+	}
+	return NewSecretMnemonic(mnemonic), nil
+}
@@ -0,0 +1,126 @@
+package bip39
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestSecretRedaction(t *testing.T) {
+	const mnemonic = "legal winner thank year wave sausage worth useful legal winner thank yellow"
+	secret := NewSecretMnemonic(mnemonic)
+	for _, format := range []string{"%v", "%+v", "%s", "%q", "%x", "%X", "%d", "%10s"} {
+		for _, value := range []any{secret, &secret, struct{ M SecretMnemonic }{secret}} {
+			s := fmt.Sprintf(format, value)
+			if strings.Contains(s, "legal") || strings.Contains(s, "6c6567616c") || !strings.Contains(s, "REDACTED") {
+				t.Fatalf("%s: leaked %s", format, s)
+			}
+		}
+	}
+	if s := fmt.Sprintf("%#v", secret); s != `bip39.SecretMnemonic("[REDACTED]")` {
+		t.Fatalf("invalid %%#v %s", s)
+	}
+	if s := fmt.Sprint(NewSecretSeed([]byte{1, 2, 3})); s != "[REDACTED]" {
+		t.Fatalf("invalid seed %s", s)
+	}
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	logger.Info("generated", "mnemonic", secret, "passphrase", NewSecretPassphrase("TREZOR"))
+	if strings.Contains(buf.String(), "legal") || strings.Contains(buf.String(), "TREZOR") || !strings.Contains(buf.String(), `"mnemonic":"[REDACTED]"`) {
+		t.Fatalf("leaked %s", buf.String())
+	}
+
+	if secret.Reveal() != mnemonic {
+		t.Fatalf("invalid mnemonic %s", secret.Reveal())
+	}
+}
+
+func TestSecretUnexportedField(t *testing.T) {
+	const mnemonic = "legal winner thank year wave sausage worth useful legal winner thank yellow"
+	seed := []byte{0xde, 0xad, 0xbe, 0xef}
+	buffer, err := NewSeedBuffer([]byte(mnemonic), []byte("TREZOR"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer buffer.Destroy()
+	// fmt cannot call the methods of unexported fields, it prints their fields instead.
+	value := struct {
+		mnemonic   SecretMnemonic
+		seed       SecretSeed
+		passphrase SecretPassphrase
+		buffer     *SecretBuffer
+	}{NewSecretMnemonic(mnemonic), NewSecretSeed(seed), NewSecretPassphrase("TREZOR"), buffer}
+	leaks := []string{"legal", "6c6567616c", "108 101 103", "TREZOR", "5452455a4f52", "84 82 69", "deadbeef", "222 173", fmt.Sprint(buffer.Bytes()[:3])[1:]}
+	for _, format := range []string{"%v", "%+v", "%#v", "%s", "%q", "%x", "%d"} {
+		for _, v := range []any{value, &value, []any{value}} {
+			s := fmt.Sprintf(format, v)
+			for _, leak := range leaks {
+				if strings.Contains(s, leak) {
+					t.Fatalf("%s: leaked %s in %s", format, leak, s)
+				}
+			}
+		}
+	}
+}
+
+func TestSecretMarshal(t *testing.T) {
+	const mnemonic = "legal winner thank year wave sausage worth useful legal winner thank yellow"
+	if _, err := json.Marshal(NewSecretMnemonic(mnemonic)); !errors.Is(err, ErrSecretMarshal) {
+		t.Fatalf("invalid error %v", err)
+	}
+	if _, err := json.Marshal(struct{ Seed SecretSeed }{NewSecretSeed([]byte{1})}); !errors.Is(err, ErrSecretMarshal) {
+		t.Fatalf("invalid error %v", err)
+	}
+	data, err := json.Marshal(struct{ Mnemonic SecretMnemonic }{NewSecretMnemonic(mnemonic, WithRevealOnMarshal())})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"Mnemonic":"`+mnemonic+`"}` {
+		t.Fatalf("invalid json %s", data)
+	}
+
+	// Seeds are not valid UTF-8, they are marshaled in hex so that no byte is lost.
+	seed := make([]byte, 64)
+	for i := range seed {
+		seed[i] = byte(0x80 + i*2)
+	}
+	data, err = json.Marshal(struct{ Seed SecretSeed }{NewSecretSeed(seed, WithRevealOnMarshal())})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded struct{ Seed string }
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Seed != hex.EncodeToString(seed) {
+		t.Fatalf("invalid json %s", data)
+	}
+}
+
+func TestSecretSeed(t *testing.T) {
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret, err := m.GenerateSecretMnemonic(WithRandom(NewDeterministicRandom([]byte("fixture"))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secret.Reveal() != "assault novel believe crisp elder junior kitchen zone essence foam quit damage" {
+		t.Fatalf("invalid mnemonic %s", secret.Reveal())
+	}
+	seed := secret.Seed(NewSecretPassphrase("TREZOR"))
+	if !bytes.Equal(seed.Reveal(), NewSeed(secret.Reveal(), WithPassphrase("TREZOR"))) {
+		t.Fatal("invalid seed")
+	}
+	seed.Reveal()[0] ^= 0xff
+	if !bytes.Equal(seed.Reveal(), NewSeed(secret.Reveal(), WithPassphrase("TREZOR"))) {
+		t.Fatal("seed modified")
+	}
+}
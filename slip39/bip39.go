@@ -0,0 +1,52 @@
+package slip39
+
+import (
+	"github.com/gofika/bip39"
+)
+
+// SplitBIP39Entropy splits the entropy of a BIP39 mnemonic into SLIP-0039 mnemonic shares,
+// so that an existing wallet can be backed up with Shamir shares without changing it.
+//
+// The entropy is the one returned by Mnemonic.EntropyFromMnemonic, 16, 20, 24, 28 or 32 bytes.
+// The shares and options are the same as GenerateMnemonics.
+//
+// Example:
+//
+//	m, err := bip39.NewMnemonic()
+//	if err != nil {
+//		panic(err)
+//	}
+//	entropy, err := m.EntropyFromMnemonic(mnemonic)
+//	if err != nil {
+//		panic(err)
+//	}
+//	shares, err := slip39.SplitBIP39Entropy(entropy, 1, []slip39.Group{{MemberThreshold: 2, MemberCount: 3}})
+func SplitBIP39Entropy(entropy []byte, groupThreshold int, groups []Group, opts ...ShareOption) ([][]string, error) {
+	switch len(entropy) {
+	case 16, 20, 24, 28, 32:
+	default:
+		return nil, bip39.ErrInvalidEntropy
+	}
+	return GenerateMnemonics(groupThreshold, groups, entropy, opts...)
+}
+
+// RecoverBIP39Mnemonic recovers the BIP39 mnemonic from SLIP-0039 shares created by SplitBIP39Entropy.
+// The mnemonic is rebuilt in the given language, which does not need to be the language of the original mnemonic.
+//
+// The wallet seed must be derived from the recovered BIP39 mnemonic with bip39.NewSeed,
+// together with the BIP39 passphrase if any. It is NOT the SLIP-0039 master secret and
+// must not be derived the way SLIP-0039 wallets derive their seed from the master secret.
+//
+// The SLIP-0039 passphrase set with WithPassphrase() option is unrelated to the BIP39 passphrase.
+// A wrong SLIP-0039 passphrase is not detected and recovers a different but valid BIP39 mnemonic.
+func RecoverBIP39Mnemonic(mnemonics []string, language bip39.Language, opts ...ShareOption) (string, error) {
+	entropy, err := CombineMnemonics(mnemonics, opts...)
+	if err != nil {
+		return "", err
+	}
+	m, err := bip39.NewMnemonic(bip39.WithLanguage(language))
+	if err != nil {
+		return "", err
+	}
+	return m.EntropyToMnemonic(entropy)
+}
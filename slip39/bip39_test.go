@@ -0,0 +1,67 @@
+package slip39
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/gofika/bip39"
+)
+
+func TestBIP39RoundTrip(t *testing.T) {
+	languages := []bip39.Language{
+		bip39.English, bip39.Japanese, bip39.Korean, bip39.Spanish, bip39.ChineseSimplified,
+		bip39.ChineseTraditional, bip39.French, bip39.Italian, bip39.Czech, bip39.Portuguese,
+	}
+	for i, lang := range languages {
+		m, err := bip39.NewMnemonic(bip39.WithLanguage(lang))
+		if err != nil {
+			t.Fatal(err)
+		}
+		mnemonic, err := m.GenerateMnemonic(bip39.WithEntropyBits(128 + i%5*32))
+		if err != nil {
+			t.Fatal(err)
+		}
+		entropy, err := m.EntropyFromMnemonic(mnemonic)
+		if err != nil {
+			t.Fatal(err)
+		}
+		shares, err := SplitBIP39Entropy(entropy, 1, []Group{{MemberThreshold: 2, MemberCount: 3}}, WithIterationExponent(0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		recovered, err := RecoverBIP39Mnemonic([]string{shares[0][2], shares[0][0]}, lang)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if recovered != mnemonic {
+			t.Fatal("mnemonic mismatch")
+		}
+		if !bytes.Equal(bip39.NewSeed(recovered, bip39.WithPassphrase("gofika")), bip39.NewSeed(mnemonic, bip39.WithPassphrase("gofika"))) {
+			t.Fatal("seed mismatch")
+		}
+
+		// The mnemonic can be recovered in another language with the same entropy.
+		recovered, err = RecoverBIP39Mnemonic([]string{shares[0][1], shares[0][2]}, bip39.English)
+		if err != nil {
+			t.Fatal(err)
+		}
+		english, err := bip39.NewMnemonic()
+		if err != nil {
+			t.Fatal(err)
+		}
+		recoveredEntropy, err := english.EntropyFromMnemonic(recovered)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(recoveredEntropy, entropy) {
+			t.Fatal("entropy mismatch")
+		}
+	}
+}
+
+func TestSplitBIP39EntropyInvalid(t *testing.T) {
+	if _, err := SplitBIP39Entropy(make([]byte, 18), 1, []Group{{1, 1}}); !errors.Is(err, bip39.ErrInvalidEntropy) {
+		t.Fatalf("expected ErrInvalidEntropy, got %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package slip39
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// baseIterationCount is the total number of PBKDF2 iterations of the Feistel cipher for iteration exponent 0.
+	baseIterationCount = 10000
+	// roundCount is the number of rounds of the Feistel cipher.
+	roundCount = 4
+	// customizationString is the salt prefix of non-extendable backups.
+	customizationString = "shamir"
+)
+
+// encrypt encrypts the master secret with the passphrase using a 4 round Feistel network.
+func encrypt(masterSecret, passphrase []byte, iterationExponent int, identifier uint16, extendable bool) []byte {
+	half := len(masterSecret) / 2
+	l := append([]byte(nil), masterSecret[:half]...)
+	r := append([]byte(nil), masterSecret[half:]...)
+	salt := cipherSalt(identifier, extendable)
+	for i := range roundCount {
+		f := roundFunction(i, passphrase, iterationExponent, salt, r)
+		l, r = r, xorBytes(l, f)
+	}
+	return append(r, l...)
+}
+
+// decrypt decrypts the encrypted master secret with the passphrase, applying the rounds in reverse order.
+func decrypt(encryptedMasterSecret, passphrase []byte, iterationExponent int, identifier uint16, extendable bool) []byte {
+	half := len(encryptedMasterSecret) / 2
+	l := append([]byte(nil), encryptedMasterSecret[:half]...)
+	r := append([]byte(nil), encryptedMasterSecret[half:]...)
+	salt := cipherSalt(identifier, extendable)
+	for i := roundCount - 1; i >= 0; i-- {
+		f := roundFunction(i, passphrase, iterationExponent, salt, r)
+		l, r = r, xorBytes(l, f)
+	}
+	return append(r, l...)
+}
+
+// roundFunction is the Feistel round function PBKDF2(i || passphrase, salt || r, (10000 << e) / 4, len(r)).
+func roundFunction(i int, passphrase []byte, iterationExponent int, salt, r []byte) []byte {
+	password := append([]byte{byte(i)}, passphrase...)
+	iterations := (baseIterationCount << iterationExponent) / roundCount
+	return pbkdf2.Key(password, append(salt[:len(salt):len(salt)], r...), iterations, len(r), sha256.New)
+}
+
+// cipherSalt returns "shamir" || identifier for non-extendable backups, and an empty salt otherwise.
+func cipherSalt(identifier uint16, extendable bool) []byte {
+	if extendable {
+		return nil
+	}
+	return binary.BigEndian.AppendUint16([]byte(customizationString), identifier)
+}
+
+func xorBytes(a, b []byte) []byte {
+	result := make([]byte, len(a))
+	for i := range a {
+		result[i] = a[i] ^ b[i]
+	}
+	return result
+}
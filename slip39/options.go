@@ -0,0 +1,59 @@
+package slip39
+
+import "io"
+
+// ShareOptions options for GenerateMnemonics and CombineMnemonics functions
+type ShareOptions struct {
+	// passphrase is used to encrypt and decrypt the master secret.
+	passphrase []byte
+	// iterationExponent is the exponent of the PBKDF2 iteration count used by GenerateMnemonics.
+	iterationExponent int
+	// extendable is the extendable backup flag used by GenerateMnemonics.
+	extendable bool
+	// extraShares accepts shares and groups beyond the thresholds in CombineMnemonics.
+	extraShares bool
+	// random is the source of the identifier and the random share values used by GenerateMnemonics.
+	random io.Reader
+}
+
+// ShareOption a function that modifies ShareOptions
+type ShareOption func(*ShareOptions)
+
+// WithPassphrase sets the passphrase used to encrypt and decrypt the master secret.
+// The passphrase must consist of printable ASCII characters.
+func WithPassphrase(passphrase string) func(*ShareOptions) {
+	return func(options *ShareOptions) {
+		options.passphrase = []byte(passphrase)
+	}
+}
+
+// WithIterationExponent sets the exponent of the PBKDF2 iteration count used by GenerateMnemonics.
+// The exponent must be in [0, 15].
+func WithIterationExponent(iterationExponent int) func(*ShareOptions) {
+	return func(options *ShareOptions) {
+		options.iterationExponent = iterationExponent
+	}
+}
+
+// WithExtendable sets the extendable backup flag used by GenerateMnemonics.
+func WithExtendable(extendable bool) func(*ShareOptions) {
+	return func(options *ShareOptions) {
+		options.extendable = extendable
+	}
+}
+
+// WithRandom sets the source of randomness used by GenerateMnemonics.
+func WithRandom(random io.Reader) func(*ShareOptions) {
+	return func(options *ShareOptions) {
+		options.random = random
+	}
+}
+
+// WithExtraShares makes CombineMnemonics accept shares and groups beyond the thresholds, which the reference
+// implementation rejects. The secret is recovered from the first shares, and the additional ones must lie
+// on the same polynomial, otherwise ErrInconsistentShares is returned. Every group given must be complete.
+func WithExtraShares() func(*ShareOptions) {
+	return func(options *ShareOptions) {
+		options.extraShares = true
+	}
+}
@@ -0,0 +1,145 @@
+package slip39
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+	"io"
+)
+
+const (
+	// digestLength is the length of the digest of the shared secret in bytes.
+	digestLength = 4
+	// digestIndex is the x coordinate of the share holding the digest of the shared secret.
+	digestIndex = 254
+	// secretIndex is the x coordinate of the share holding the shared secret.
+	secretIndex = 255
+)
+
+// expTable and logTable are the exponent and logarithm tables of GF(256)
+// with the Rijndael polynomial x^8 + x^4 + x^3 + x + 1 and generator x + 1.
+var expTable, logTable = func() (exp [255]byte, log [256]byte) {
+	poly := 1
+	for i := range 255 {
+		exp[i] = byte(poly)
+		log[poly] = byte(i)
+		// Multiply poly by the generator x + 1.
+		poly = (poly << 1) ^ poly
+		if poly&0x100 != 0 {
+			poly ^= 0x11b
+		}
+	}
+	return
+}()
+
+// gfLog returns the logarithm of a non-zero element of GF(256).
+func gfLog(a byte) int {
+	return int(logTable[a])
+}
+
+type rawShare struct {
+	x    byte
+	data []byte
+}
+
+// interpolate returns the value at x of the polynomial of the lowest degree passing through the shares.
+// All shares must have distinct x coordinates and data of the same length.
+func interpolate(shares []rawShare, x byte) []byte {
+	for _, share := range shares {
+		if share.x == x {
+			return append([]byte(nil), share.data...)
+		}
+	}
+
+	// Lagrange interpolation in the logarithmic domain:
+	// basis_i(x) = prod_{j != i} (x - x_j) / (x_i - x_j), where subtraction is XOR in GF(256).
+	var logProd int
+	for _, share := range shares {
+		logProd += gfLog(share.x ^ x)
+	}
+
+	result := make([]byte, len(shares[0].data))
+	for i, share := range shares {
+		logBasis := logProd - gfLog(share.x^x)
+		for j, other := range shares {
+			if j != i {
+				logBasis -= gfLog(share.x ^ other.x)
+			}
+		}
+		logBasis = ((logBasis % 255) + 255) % 255
+		for k, y := range share.data {
+			if y != 0 {
+				result[k] ^= expTable[(gfLog(y)+logBasis)%255]
+			}
+		}
+	}
+	return result
+}
+
+// splitSecret splits secret into shareCount shares of which threshold are needed to recover it.
+func splitSecret(threshold, shareCount int, secret []byte, random io.Reader) ([]rawShare, error) {
+	if threshold < 1 || threshold > shareCount || shareCount > maxShareCount {
+		return nil, fmt.Errorf("%w: threshold %d of %d shares", ErrInvalidThreshold, threshold, shareCount)
+	}
+	shares := make([]rawShare, 0, shareCount)
+	if threshold == 1 {
+		for i := range shareCount {
+			shares = append(shares, rawShare{x: byte(i), data: append([]byte(nil), secret...)})
+		}
+		return shares, nil
+	}
+
+	// threshold - 2 shares are random, the digest and the secret shares fix the remaining two points.
+	randomShareCount := threshold - 2
+	for i := range randomShareCount {
+		data := make([]byte, len(secret))
+		if _, err := io.ReadFull(random, data); err != nil {
+			return nil, err
+		}
+		shares = append(shares, rawShare{x: byte(i), data: data})
+	}
+	randomPart := make([]byte, len(secret)-digestLength)
+	if _, err := io.ReadFull(random, randomPart); err != nil {
+		return nil, err
+	}
+	digest := createDigest(randomPart, secret)
+
+	baseShares := append(shares[:len(shares):len(shares)],
+		rawShare{x: digestIndex, data: append(digest, randomPart...)},
+		rawShare{x: secretIndex, data: secret},
+	)
+	for i := randomShareCount; i < shareCount; i++ {
+		shares = append(shares, rawShare{x: byte(i), data: interpolate(baseShares, byte(i))})
+	}
+	return shares, nil
+}
+
+// recoverSecret recovers the shared secret from threshold shares and verifies its digest.
+func recoverSecret(threshold int, shares []rawShare) ([]byte, error) {
+	if threshold == 1 {
+		return append([]byte(nil), shares[0].data...), nil
+	}
+	secret := interpolate(shares, secretIndex)
+	digestShare := interpolate(shares, digestIndex)
+	digest, randomPart := digestShare[:digestLength], digestShare[digestLength:]
+	if !hmac.Equal(digest, createDigest(randomPart, secret)) {
+		return nil, ErrInvalidDigest
+	}
+	return secret, nil
+}
+
+// sharesConsistent reports whether the shares beyond the first threshold ones lie on the polynomial of those.
+func sharesConsistent(shares []rawShare, threshold int) bool {
+	for _, share := range shares[threshold:] {
+		if !hmac.Equal(interpolate(shares[:threshold], share.x), share.data) {
+			return false
+		}
+	}
+	return true
+}
+
+func createDigest(randomData, secret []byte) []byte {
+	h := hmac.New(sha256.New, randomData)
+	h.Write(secret)
+	return h.Sum(nil)[:digestLength]
+}
@@ -0,0 +1,202 @@
+package slip39
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/gofika/bip39/wordlists"
+)
+
+const (
+	// radixBits is the number of bits encoded by each word.
+	radixBits = 10
+	// idLengthBits is the length of the random identifier in bits.
+	idLengthBits = 15
+	// iterationExponentBits is the length of the iteration exponent in bits.
+	iterationExponentBits = 4
+	// idExpLengthWords is the number of words encoding the identifier, extendable flag and iteration exponent.
+	idExpLengthWords = 2
+	// checksumLengthWords is the number of words of the RS1024 checksum.
+	checksumLengthWords = 3
+	// metadataLengthWords is the number of words of a share besides the share value.
+	metadataLengthWords = idExpLengthWords + 2 + checksumLengthWords
+	// minStrengthBits is the minimum length of the master secret in bits.
+	minStrengthBits = 128
+	// minMnemonicLengthWords is the number of words of a share of a 128 bits master secret.
+	minMnemonicLengthWords = metadataLengthWords + (minStrengthBits+radixBits-1)/radixBits
+	// maxShareCount is the maximum number of groups and of members of a group.
+	maxShareCount = 16
+)
+
+// Share is a single decoded SLIP-0039 mnemonic share.
+type Share struct {
+	// Identifier is the random 15 bits identifier shared by all shares of the same master secret.
+	Identifier uint16
+	// Extendable reports whether the backup can be extended with more shares for the same master secret.
+	Extendable bool
+	// IterationExponent is the exponent of the PBKDF2 iteration count, 10000 << IterationExponent.
+	IterationExponent int
+	// GroupIndex is the zero-based index of the group the share belongs to.
+	GroupIndex int
+	// GroupThreshold is the number of groups required to recover the master secret.
+	GroupThreshold int
+	// GroupCount is the total number of groups.
+	GroupCount int
+	// MemberIndex is the zero-based index of the share within its group.
+	MemberIndex int
+	// MemberThreshold is the number of shares of the group required to recover the group secret.
+	MemberThreshold int
+	// Value is the share value.
+	Value []byte
+}
+
+// ParseShare decodes and validates a single mnemonic share.
+//
+// The words are matched case-insensitively and may be separated by any whitespace.
+func ParseShare(mnemonic string) (*Share, error) {
+	words := strings.Fields(strings.ToLower(mnemonic))
+	if len(words) < minMnemonicLengthWords {
+		return nil, fmt.Errorf("%w: %d words, at least %d required", ErrInvalidMnemonicLength, len(words), minMnemonicLengthWords)
+	}
+	indices := make([]int, len(words))
+	for i, word := range words {
+		index, ok := wordlists.SLIP39Map[word]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q at position %d", ErrInvalidWord, word, i)
+		}
+		indices[i] = index
+	}
+
+	paddingLen := (radixBits * (len(words) - metadataLengthWords)) % 16
+	if paddingLen > 8 {
+		return nil, fmt.Errorf("%w: %d words", ErrInvalidMnemonicLength, len(words))
+	}
+
+	idExpInt := indices[0]<<radixBits | indices[1]
+	identifier := uint16(idExpInt >> (iterationExponentBits + 1))
+	extendable := (idExpInt>>iterationExponentBits)&1 == 1
+	if !rs1024Verify(indices, extendable) {
+		return nil, ErrInvalidChecksum
+	}
+
+	share := &Share{
+		Identifier:        identifier,
+		Extendable:        extendable,
+		IterationExponent: idExpInt & (1<<iterationExponentBits - 1),
+		GroupIndex:        indices[2] >> 6,
+		GroupThreshold:    (indices[2]>>2)&0xf + 1,
+		GroupCount:        ((indices[2]&0x3)<<2 | indices[3]>>8) + 1,
+		MemberIndex:       (indices[3] >> 4) & 0xf,
+		MemberThreshold:   indices[3]&0xf + 1,
+	}
+	if share.GroupCount < share.GroupThreshold {
+		return nil, fmt.Errorf("%w: group threshold %d is greater than group count %d", ErrInvalidThreshold, share.GroupThreshold, share.GroupCount)
+	}
+
+	valueWords := indices[idExpLengthWords+2 : len(indices)-checksumLengthWords]
+	value := new(big.Int)
+	for _, index := range valueWords {
+		value.Lsh(value, radixBits)
+		value.Or(value, big.NewInt(int64(index)))
+	}
+	valueBytesLen := (radixBits*len(valueWords) - paddingLen) / 8
+	if value.BitLen() > valueBytesLen*8 {
+		return nil, ErrInvalidPadding
+	}
+	share.Value = value.FillBytes(make([]byte, valueBytesLen))
+	return share, nil
+}
+
+// Words returns the words of the share.
+func (s *Share) Words() []string {
+	idExpInt := int(s.Identifier)<<(iterationExponentBits+1) | s.IterationExponent
+	if s.Extendable {
+		idExpInt |= 1 << iterationExponentBits
+	}
+	indices := []int{
+		idExpInt >> radixBits,
+		idExpInt & (1<<radixBits - 1),
+		s.GroupIndex<<6 | (s.GroupThreshold-1)<<2 | (s.GroupCount-1)>>2,
+		((s.GroupCount-1)&0x3)<<8 | s.MemberIndex<<4 | (s.MemberThreshold - 1),
+	}
+
+	// The share value is left padded with zero bits to a multiple of the word size.
+	valueWordCount := (len(s.Value)*8 + radixBits - 1) / radixBits
+	value := new(big.Int).SetBytes(s.Value)
+	valueIndices := make([]int, valueWordCount)
+	mask := big.NewInt(1<<radixBits - 1)
+	for i := valueWordCount - 1; i >= 0; i-- {
+		valueIndices[i] = int(new(big.Int).And(value, mask).Int64())
+		value.Rsh(value, radixBits)
+	}
+	indices = append(indices, valueIndices...)
+	indices = append(indices, rs1024CreateChecksum(indices, s.Extendable)...)
+
+	words := make([]string, len(indices))
+	for i, index := range indices {
+		words[i] = wordlists.SLIP39[index]
+	}
+	return words
+}
+
+// String returns the mnemonic of the share.
+func (s *Share) String() string {
+	return strings.Join(s.Words(), " ")
+}
+
+// commonParametersMatch reports whether two shares belong to the same backup.
+func (s *Share) commonParametersMatch(other *Share) bool {
+	return s.Identifier == other.Identifier &&
+		s.Extendable == other.Extendable &&
+		s.IterationExponent == other.IterationExponent &&
+		s.GroupThreshold == other.GroupThreshold &&
+		s.GroupCount == other.GroupCount
+}
+
+var rs1024Generator = [10]uint32{
+	0xe0e040, 0x1c1c080, 0x3838100, 0x7070200, 0xe0e0009,
+	0x1c0c2412, 0x38086c24, 0x3090fc48, 0x21b1f890, 0x3f3f120,
+}
+
+func rs1024Polymod(values []int) uint32 {
+	chk := uint32(1)
+	for _, v := range values {
+		b := chk >> 20
+		chk = (chk&0xfffff)<<10 ^ uint32(v)
+		for i, g := range rs1024Generator {
+			if (b>>i)&1 == 1 {
+				chk ^= g
+			}
+		}
+	}
+	return chk
+}
+
+// rs1024Customization returns the customization string of the checksum as word values.
+func rs1024Customization(extendable bool) []int {
+	customization := customizationString
+	if extendable {
+		customization = customizationString + "_extendable"
+	}
+	values := make([]int, len(customization))
+	for i := range customization {
+		values[i] = int(customization[i])
+	}
+	return values
+}
+
+func rs1024CreateChecksum(data []int, extendable bool) []int {
+	values := append(rs1024Customization(extendable), data...)
+	values = append(values, make([]int, checksumLengthWords)...)
+	polymod := rs1024Polymod(values) ^ 1
+	checksum := make([]int, checksumLengthWords)
+	for i := range checksum {
+		checksum[i] = int(polymod>>(radixBits*(checksumLengthWords-1-i))) & (1<<radixBits - 1)
+	}
+	return checksum
+}
+
+func rs1024Verify(data []int, extendable bool) bool {
+	return rs1024Polymod(append(rs1024Customization(extendable), data...)) == 1
+}
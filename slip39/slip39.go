@@ -0,0 +1,251 @@
+// Package slip39 implements SLIP-0039 Shamir's Secret-Sharing for mnemonic codes.
+//
+// A master secret is split into groups of mnemonic shares. Recovering the master secret
+// requires a threshold of groups, each recovered from a threshold of its member shares.
+//
+//	// 2 of 3 groups: a 1-of-1 group, a 1-of-1 group and a 2-of-5 group
+//	shares, err := slip39.GenerateMnemonics(2, []slip39.Group{{1, 1}, {1, 1}, {2, 5}}, masterSecret, slip39.WithPassphrase("TREZOR"))
+//	if err != nil {
+//		panic(err)
+//	}
+//	masterSecret, err = slip39.CombineMnemonics([]string{shares[0][0], shares[2][0], shares[2][3]}, slip39.WithPassphrase("TREZOR"))
+package slip39
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	ErrInvalidMnemonicLength  = errors.New("invalid mnemonic length")
+	ErrInvalidWord            = errors.New("invalid word")
+	ErrInvalidChecksum        = errors.New("checksum incorrect")
+	ErrInvalidPadding         = errors.New("invalid padding")
+	ErrInvalidThreshold       = errors.New("invalid threshold")
+	ErrInvalidMasterSecret    = errors.New("invalid master secret")
+	ErrInvalidPassphrase      = errors.New("invalid passphrase")
+	ErrInvalidIterationExp    = errors.New("invalid iteration exponent")
+	ErrEmptyMnemonics         = errors.New("empty mnemonics")
+	ErrMismatchedParameters   = errors.New("mismatched share parameters")
+	ErrMismatchedValueLength  = errors.New("mismatched share value length")
+	ErrDuplicateMemberIndex   = errors.New("duplicate member index")
+	ErrInsufficientGroups     = errors.New("insufficient groups")
+	ErrInsufficientShares     = errors.New("insufficient member shares")
+	ErrTooManyGroups          = errors.New("too many groups")
+	ErrTooManyShares          = errors.New("too many member shares")
+	ErrInvalidDigest          = errors.New("invalid digest of the shared secret")
+	ErrInconsistentShares     = errors.New("inconsistent shares")
+	ErrSingleMemberMultiShare = errors.New("multiple member shares with member threshold 1 are not allowed")
+)
+
+// Group describes a group of member shares.
+type Group struct {
+	// MemberThreshold is the number of member shares required to recover the group secret.
+	MemberThreshold int
+	// MemberCount is the number of member shares of the group.
+	MemberCount int
+}
+
+// GenerateMnemonics splits a master secret into mnemonic shares.
+//
+// The result holds the mnemonics of each group, in the order of groups.
+// The master secret must be at least 16 bytes and of even length, such as the entropy of a BIP39 mnemonic.
+// groupThreshold groups are required to recover the master secret.
+//
+// The default passphrase is empty, the default iteration exponent is 1,
+// and backups are extendable by default as recommended by SLIP-0039.
+// Use WithPassphrase(), WithIterationExponent() and WithExtendable() options to change them.
+func GenerateMnemonics(groupThreshold int, groups []Group, masterSecret []byte, opts ...ShareOption) ([][]string, error) {
+	options := &ShareOptions{
+		iterationExponent: 1,
+		extendable:        true,
+		random:            rand.Reader,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if len(masterSecret)*8 < minStrengthBits || len(masterSecret)%2 != 0 {
+		return nil, fmt.Errorf("%w: %d bytes", ErrInvalidMasterSecret, len(masterSecret))
+	}
+	if err := checkPassphrase(options.passphrase); err != nil {
+		return nil, err
+	}
+	if options.iterationExponent < 0 || options.iterationExponent >= 1<<iterationExponentBits {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidIterationExp, options.iterationExponent)
+	}
+	if groupThreshold < 1 || groupThreshold > len(groups) || len(groups) > maxShareCount {
+		return nil, fmt.Errorf("%w: group threshold %d of %d groups", ErrInvalidThreshold, groupThreshold, len(groups))
+	}
+	for i, group := range groups {
+		if group.MemberThreshold == 1 && group.MemberCount > 1 {
+			return nil, fmt.Errorf("%w: group %d", ErrSingleMemberMultiShare, i)
+		}
+		if group.MemberThreshold < 1 || group.MemberThreshold > group.MemberCount || group.MemberCount > maxShareCount {
+			return nil, fmt.Errorf("%w: member threshold %d of %d shares in group %d", ErrInvalidThreshold, group.MemberThreshold, group.MemberCount, i)
+		}
+	}
+
+	var idBytes [2]byte
+	if _, err := io.ReadFull(options.random, idBytes[:]); err != nil {
+		return nil, err
+	}
+	identifier := binary.BigEndian.Uint16(idBytes[:]) & (1<<idLengthBits - 1)
+
+	encryptedMasterSecret := encrypt(masterSecret, options.passphrase, options.iterationExponent, identifier, options.extendable)
+	groupShares, err := splitSecret(groupThreshold, len(groups), encryptedMasterSecret, options.random)
+	if err != nil {
+		return nil, err
+	}
+
+	mnemonics := make([][]string, len(groups))
+	for i, group := range groups {
+		memberShares, err := splitSecret(group.MemberThreshold, group.MemberCount, groupShares[i].data, options.random)
+		if err != nil {
+			return nil, err
+		}
+		for _, memberShare := range memberShares {
+			share := &Share{
+				Identifier:        identifier,
+				Extendable:        options.extendable,
+				IterationExponent: options.iterationExponent,
+				GroupIndex:        int(groupShares[i].x),
+				GroupThreshold:    groupThreshold,
+				GroupCount:        len(groups),
+				MemberIndex:       int(memberShare.x),
+				MemberThreshold:   group.MemberThreshold,
+				Value:             memberShare.data,
+			}
+			mnemonics[i] = append(mnemonics[i], share.String())
+		}
+	}
+	return mnemonics, nil
+}
+
+// CombineMnemonics recovers the master secret from mnemonic shares.
+//
+// As in the reference implementation, the mnemonics must hold exactly the group threshold of groups,
+// and every group exactly its member threshold of shares, otherwise ErrInsufficientGroups, ErrTooManyGroups,
+// ErrInsufficientShares or ErrTooManyShares is returned. The same mnemonic given twice counts once.
+// With WithExtraShares() option, additional shares and groups are accepted and checked against the others.
+// The passphrase is set with WithPassphrase() option. A wrong passphrase is not detected
+// and results in a different master secret, as SLIP-0039 provides plausible deniability.
+func CombineMnemonics(mnemonics []string, opts ...ShareOption) ([]byte, error) {
+	options := &ShareOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if err := checkPassphrase(options.passphrase); err != nil {
+		return nil, err
+	}
+	shares := make([]*Share, 0, len(mnemonics))
+	for _, mnemonic := range mnemonics {
+		share, err := ParseShare(mnemonic)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, share)
+	}
+	encryptedMasterSecret, err := recoverEncryptedMasterSecret(shares, options.extraShares)
+	if err != nil {
+		return nil, err
+	}
+	first := shares[0]
+	return decrypt(encryptedMasterSecret, options.passphrase, first.IterationExponent, first.Identifier, first.Extendable), nil
+}
+
+// ValidateMnemonic checks that a single mnemonic share is well formed,
+// returning the reason as one of the package errors if it is not.
+func ValidateMnemonic(mnemonic string) error {
+	_, err := ParseShare(mnemonic)
+	return err
+}
+
+// recoverEncryptedMasterSecret checks that the shares form a consistent set and recovers the encrypted master secret.
+// With extra, the shares beyond the thresholds must lie on the polynomials of the first ones.
+func recoverEncryptedMasterSecret(shares []*Share, extra bool) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, ErrEmptyMnemonics
+	}
+	first := shares[0]
+	groups := make(map[int][]*Share)
+	var groupOrder []int
+	for _, share := range shares {
+		if !share.commonParametersMatch(first) {
+			return nil, ErrMismatchedParameters
+		}
+		if len(share.Value) != len(first.Value) {
+			return nil, ErrMismatchedValueLength
+		}
+		members, ok := groups[share.GroupIndex]
+		if !ok {
+			groupOrder = append(groupOrder, share.GroupIndex)
+		}
+		for _, member := range members {
+			if member.MemberThreshold != share.MemberThreshold {
+				return nil, fmt.Errorf("%w: member thresholds of group %d differ", ErrMismatchedParameters, share.GroupIndex)
+			}
+			if member.MemberIndex == share.MemberIndex {
+				if string(member.Value) != string(share.Value) {
+					return nil, fmt.Errorf("%w: %d in group %d", ErrDuplicateMemberIndex, share.MemberIndex, share.GroupIndex)
+				}
+				// The same share was provided twice.
+				share = nil
+				break
+			}
+		}
+		if share != nil {
+			groups[share.GroupIndex] = append(members, share)
+		}
+	}
+
+	if len(groupOrder) < first.GroupThreshold {
+		return nil, fmt.Errorf("%w: %d of %d groups", ErrInsufficientGroups, len(groupOrder), first.GroupThreshold)
+	}
+	if !extra && len(groupOrder) > first.GroupThreshold {
+		return nil, fmt.Errorf("%w: %d groups, expected %d", ErrTooManyGroups, len(groupOrder), first.GroupThreshold)
+	}
+	groupShares := make([]rawShare, 0, len(groupOrder))
+	for _, groupIndex := range groupOrder {
+		members := groups[groupIndex]
+		threshold := members[0].MemberThreshold
+		if len(members) < threshold {
+			return nil, fmt.Errorf("%w: group %d has %d of %d shares", ErrInsufficientShares, groupIndex, len(members), threshold)
+		}
+		if !extra && len(members) > threshold {
+			return nil, fmt.Errorf("%w: group %d has %d shares, expected %d", ErrTooManyShares, groupIndex, len(members), threshold)
+		}
+		memberShares := make([]rawShare, len(members))
+		for i, member := range members {
+			memberShares[i] = rawShare{x: byte(member.MemberIndex), data: member.Value}
+		}
+		groupSecret, err := recoverSecret(threshold, memberShares[:threshold])
+		if err != nil {
+			return nil, err
+		}
+		if !sharesConsistent(memberShares, threshold) {
+			return nil, fmt.Errorf("%w: group %d", ErrInconsistentShares, groupIndex)
+		}
+		groupShares = append(groupShares, rawShare{x: byte(groupIndex), data: groupSecret})
+	}
+	secret, err := recoverSecret(first.GroupThreshold, groupShares[:first.GroupThreshold])
+	if err != nil {
+		return nil, err
+	}
+	if !sharesConsistent(groupShares, first.GroupThreshold) {
+		return nil, fmt.Errorf("%w: groups", ErrInconsistentShares)
+	}
+	return secret, nil
+}
+
+// checkPassphrase checks that the passphrase consists of printable ASCII characters.
+func checkPassphrase(passphrase []byte) error {
+	for _, c := range passphrase {
+		if c < 32 || c > 126 {
+			return ErrInvalidPassphrase
+		}
+	}
+	return nil
+}
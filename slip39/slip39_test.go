@@ -0,0 +1,250 @@
+package slip39
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gofika/bip39"
+)
+
+// TestVectors checks testdata/vectors.json, in the format of the reference vectors:
+// https://github.com/trezor/python-shamir-mnemonic/blob/master/vectors.json
+// Each vector is a description, the mnemonics, the master secret, empty if the mnemonics are invalid,
+// and the BIP32 master key of the master secret, which is not checked here.
+// The file holds the vectors checked against the reference where this test was written,
+// the reference file can replace it as is.
+func TestVectors(t *testing.T) {
+	data, err := os.ReadFile("testdata/vectors.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var vectors [][4]json.RawMessage
+	if err := json.Unmarshal(data, &vectors); err != nil {
+		t.Fatal(err)
+	}
+	for _, vector := range vectors {
+		var description, masterSecret string
+		var mnemonics []string
+		if err := errors.Join(json.Unmarshal(vector[0], &description), json.Unmarshal(vector[1], &mnemonics), json.Unmarshal(vector[2], &masterSecret)); err != nil {
+			t.Fatal(err)
+		}
+		recovered, err := CombineMnemonics(mnemonics, WithPassphrase("TREZOR"))
+		if masterSecret == "" {
+			if err == nil {
+				t.Fatalf("%s: unexpected valid mnemonics", description)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", description, err)
+		}
+		if hex.EncodeToString(recovered) != masterSecret {
+			t.Fatalf("%s: master secret mismatch", description)
+		}
+	}
+}
+
+func TestGenerateAndCombine(t *testing.T) {
+	for _, extendable := range []bool{false, true} {
+		masterSecret := make([]byte, 32)
+		if _, err := rand.Read(masterSecret); err != nil {
+			t.Fatal(err)
+		}
+		groups := []Group{{1, 1}, {1, 1}, {2, 5}, {3, 4}}
+		mnemonics, err := GenerateMnemonics(2, groups, masterSecret, WithPassphrase("gofika"), WithIterationExponent(0), WithExtendable(extendable))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(mnemonics) != len(groups) {
+			t.Fatal("invalid group count")
+		}
+		for i, group := range groups {
+			if len(mnemonics[i]) != group.MemberCount {
+				t.Fatal("invalid member count")
+			}
+			for _, mnemonic := range mnemonics[i] {
+				if err := ValidateMnemonic(mnemonic); err != nil {
+					t.Fatal(err)
+				}
+				share, err := ParseShare(mnemonic)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if share.String() != mnemonic || share.Extendable != extendable {
+					t.Fatal("share round trip mismatch")
+				}
+			}
+		}
+
+		combinations := [][]string{
+			{mnemonics[0][0], mnemonics[1][0]},
+			{mnemonics[2][4], mnemonics[0][0], mnemonics[2][1]},
+			// Duplicated shares are ignored.
+			{mnemonics[3][3], mnemonics[3][0], mnemonics[3][1], mnemonics[3][0], mnemonics[2][2], mnemonics[2][3]},
+		}
+		for _, combination := range combinations {
+			recovered, err := CombineMnemonics(combination, WithPassphrase("gofika"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(recovered, masterSecret) {
+				t.Fatal("master secret mismatch")
+			}
+		}
+
+		// Extra shares and groups are rejected, unless WithExtraShares() option is used.
+		extra := []string{mnemonics[1][0], mnemonics[2][0], mnemonics[2][4], mnemonics[3][0], mnemonics[3][1], mnemonics[3][2], mnemonics[3][3]}
+		if _, err := CombineMnemonics(extra, WithPassphrase("gofika")); !errors.Is(err, ErrTooManyGroups) {
+			t.Fatalf("expected ErrTooManyGroups, got %v", err)
+		}
+		recovered, err := CombineMnemonics(extra, WithPassphrase("gofika"), WithExtraShares())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(recovered, masterSecret) {
+			t.Fatal("master secret mismatch")
+		}
+
+		// A wrong passphrase gives a different master secret.
+		recovered, err = CombineMnemonics(combinations[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Equal(recovered, masterSecret) {
+			t.Fatal("unexpected master secret")
+		}
+
+		if _, err := CombineMnemonics([]string{mnemonics[2][0], mnemonics[0][0]}); !errors.Is(err, ErrInsufficientShares) {
+			t.Fatalf("expected ErrInsufficientShares, got %v", err)
+		}
+		if _, err := CombineMnemonics([]string{mnemonics[0][0]}); !errors.Is(err, ErrInsufficientGroups) {
+			t.Fatalf("expected ErrInsufficientGroups, got %v", err)
+		}
+	}
+}
+
+// TestShareSets covers the cases of the reference vectors.json beyond the vectors of TestVectors:
+// the official file could not be fetched where this test was written, so the share sets are built
+// from generated shares, changing their fields as the reference vectors do.
+func TestShareSets(t *testing.T) {
+	for _, size := range []int{16, 32} {
+		masterSecret := bytes.Repeat([]byte{0x5a}, size)
+		generate := func(seed string) [][]string {
+			mnemonics, err := GenerateMnemonics(2, []Group{{1, 1}, {1, 1}, {3, 5}, {2, 6}}, masterSecret,
+				WithPassphrase("TREZOR"), WithIterationExponent(0), WithRandom(bip39.NewDeterministicRandom([]byte(seed))))
+			if err != nil {
+				t.Fatal(err)
+			}
+			return mnemonics
+		}
+		m, other := generate("slip39"), generate("other")
+		modify := func(mnemonic string, change func(share *Share)) string {
+			share, err := ParseShare(mnemonic)
+			if err != nil {
+				t.Fatal(err)
+			}
+			change(share)
+			return share.String()
+		}
+
+		tests := []struct {
+			description string
+			mnemonics   []string
+			extra       bool
+			err         error
+		}{
+			{"Mnemonics with different identifiers", []string{m[0][0], other[1][0]}, false, ErrMismatchedParameters},
+			{"Mnemonics with different iteration exponents", []string{m[0][0], modify(m[1][0], func(s *Share) { s.IterationExponent = 2 })}, false, ErrMismatchedParameters},
+			{"Mnemonics with mismatching group thresholds", []string{m[0][0], modify(m[1][0], func(s *Share) { s.GroupThreshold = 3 })}, false, ErrMismatchedParameters},
+			{"Mnemonics with mismatching group counts", []string{m[0][0], modify(m[1][0], func(s *Share) { s.GroupCount = 5 })}, false, ErrMismatchedParameters},
+			{"Mnemonics with greater group threshold than group counts", []string{m[0][0], modify(m[1][0], func(s *Share) { s.GroupThreshold, s.GroupCount = 3, 2 })}, false, ErrInvalidThreshold},
+			{"Mnemonics with duplicate member indices", []string{m[0][0], m[2][0], modify(m[2][1], func(s *Share) { s.MemberIndex = 0 }), m[2][2]}, false, ErrDuplicateMemberIndex},
+			{"Mnemonics with mismatching member thresholds", []string{m[0][0], m[2][0], modify(m[2][1], func(s *Share) { s.MemberThreshold = 2 }), m[2][2]}, false, ErrMismatchedParameters},
+			{"Mnemonics giving an invalid digest", []string{m[0][0], m[2][0], modify(m[2][1], func(s *Share) { s.Value[0] ^= 1 }), m[2][2]}, false, ErrInvalidDigest},
+			{"Insufficient number of groups, case 1", []string{m[0][0]}, false, ErrInsufficientGroups},
+			{"Insufficient number of groups, case 2", []string{m[2][0], m[2][1], m[2][2]}, false, ErrInsufficientGroups},
+			{"Threshold number of groups, but insufficient number of members in one group", []string{m[0][0], m[3][4]}, false, ErrInsufficientShares},
+			{"Threshold number of groups and members in each group, case 1", []string{m[2][4], m[2][0], m[2][2], m[3][1], m[3][5]}, false, nil},
+			{"Threshold number of groups and members in each group, case 2", []string{m[0][0], m[3][3], m[3][0]}, false, nil},
+			{"Threshold number of groups and members in each group, case 3", []string{m[1][0], m[0][0]}, false, nil},
+			{"Mnemonic with insufficient length", []string{strings.Join(strings.Fields(m[0][0])[:minMnemonicLengthWords-1], " ")}, false, ErrInvalidMnemonicLength},
+			{"Mnemonic with invalid master secret length", []string{m[0][0] + " academic"}, false, ErrInvalidMnemonicLength},
+			{"Additional member", []string{m[0][0], m[3][0], m[3][1], m[3][2]}, false, ErrTooManyShares},
+			{"Additional group", []string{m[0][0], m[3][0], m[3][1], m[1][0]}, false, ErrTooManyGroups},
+			{"Incomplete additional group", []string{m[0][0], m[1][0], m[3][0]}, false, ErrTooManyGroups},
+			// With WithExtraShares() option, additional shares and groups are checked instead.
+			{"Consistent additional member", []string{m[0][0], m[3][0], m[3][1], m[3][2]}, true, nil},
+			{"Inconsistent additional member", []string{m[0][0], m[3][0], m[3][1], modify(m[3][2], func(s *Share) { s.Value[0] ^= 1 })}, true, ErrInconsistentShares},
+			{"Consistent additional group", []string{m[0][0], m[3][0], m[3][1], m[1][0]}, true, nil},
+			{"Inconsistent additional group", []string{m[0][0], m[3][0], m[3][1], modify(m[1][0], func(s *Share) { s.Value[0] ^= 1 })}, true, ErrInconsistentShares},
+			{"Incomplete additional group", []string{m[0][0], m[1][0], m[3][0]}, true, ErrInsufficientShares},
+		}
+		for _, test := range tests {
+			opts := []ShareOption{WithPassphrase("TREZOR")}
+			if test.extra {
+				opts = append(opts, WithExtraShares())
+			}
+			recovered, err := CombineMnemonics(test.mnemonics, opts...)
+			if test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Fatalf("%s (%d bits): expected %v, got %v", test.description, size*8, test.err, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%s (%d bits): %v", test.description, size*8, err)
+			}
+			if !bytes.Equal(recovered, masterSecret) {
+				t.Fatalf("%s (%d bits): master secret mismatch", test.description, size*8)
+			}
+		}
+	}
+}
+
+func TestGenerateMnemonicsErrors(t *testing.T) {
+	masterSecret := make([]byte, 16)
+	tests := []struct {
+		groupThreshold int
+		groups         []Group
+		masterSecret   []byte
+		opts           []ShareOption
+		err            error
+	}{
+		{1, []Group{{1, 1}}, make([]byte, 14), nil, ErrInvalidMasterSecret},
+		{1, []Group{{1, 1}}, make([]byte, 17), nil, ErrInvalidMasterSecret},
+		{2, []Group{{1, 1}}, masterSecret, nil, ErrInvalidThreshold},
+		{0, []Group{{1, 1}}, masterSecret, nil, ErrInvalidThreshold},
+		{1, []Group{{3, 2}}, masterSecret, nil, ErrInvalidThreshold},
+		{1, []Group{{2, 17}}, masterSecret, nil, ErrInvalidThreshold},
+		{1, []Group{{1, 2}}, masterSecret, nil, ErrSingleMemberMultiShare},
+		{1, []Group{{1, 1}}, masterSecret, []ShareOption{WithPassphrase("gofika\n")}, ErrInvalidPassphrase},
+		{1, []Group{{1, 1}}, masterSecret, []ShareOption{WithIterationExponent(16)}, ErrInvalidIterationExp},
+	}
+	for i, test := range tests {
+		if _, err := GenerateMnemonics(test.groupThreshold, test.groups, test.masterSecret, test.opts...); !errors.Is(err, test.err) {
+			t.Fatalf("#%d: expected %v, got %v", i, test.err, err)
+		}
+	}
+}
+
+func TestParseShareErrors(t *testing.T) {
+	valid := "duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard"
+	if _, err := ParseShare("duckling enlarge academic academic agency"); !errors.Is(err, ErrInvalidMnemonicLength) {
+		t.Fatalf("expected ErrInvalidMnemonicLength, got %v", err)
+	}
+	if _, err := ParseShare(valid + " academic"); !errors.Is(err, ErrInvalidMnemonicLength) {
+		t.Fatalf("expected ErrInvalidMnemonicLength, got %v", err)
+	}
+	if _, err := ParseShare("duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision abandon"); !errors.Is(err, ErrInvalidWord) {
+		t.Fatalf("expected ErrInvalidWord, got %v", err)
+	}
+	if _, err := ParseShare("  DUCKLING enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard\n"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,236 @@
+package bip39
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// defaultMaxCandidates is the default number of candidates suggested for each unknown word.
+	defaultMaxCandidates = 5
+	// defaultMaxDistance is the default largest distance of the suggested candidates.
+	defaultMaxDistance = 2
+	// maxSuggestCombinations is the largest number of combinations of candidates checked against the checksum.
+	maxSuggestCombinations = 1 << 16
+)
+
+// Suggestions are the corrections suggested for the unknown words of a mnemonic.
+type Suggestions struct {
+	// Language is the language of the wordlist the candidates are taken from.
+	Language Language
+	// Words are the unknown words, in the order of the mnemonic.
+	Words []WordSuggestion
+	// Matches is the number of combinations of candidates that satisfy the checksum.
+	Matches int
+	// Mnemonic is the likely intended mnemonic, if exactly one combination of candidates satisfies the checksum.
+	// It is empty otherwise.
+	Mnemonic string
+}
+
+// WordSuggestion are the candidates for an unknown word of a mnemonic.
+type WordSuggestion struct {
+	// Position is the index of the word in the mnemonic, starting at 0.
+	Position int
+	// Word is the unknown word, in NFKD form.
+	Word string
+	// Candidates are the words of the wordlist close to Word, the most likely first.
+	Candidates []Candidate
+}
+
+// Candidate is a word of the wordlist suggested for an unknown word.
+type Candidate struct {
+	// Word is the word of the wordlist, in NFKD form.
+	Word string
+	// Distance is the number of edits from the unknown word to Word: inserted, deleted or substituted characters,
+	// and swapped adjacent characters. The substitution of a key adjacent on a QWERTY keyboard counts as half an edit.
+	Distance float64
+}
+
+// Suggest suggests corrections for the unknown words of a mnemonic, from the wordlist of its detected language.
+// The language is the one with the most words of the mnemonic, see Mnemonic.Suggest.
+func Suggest(mnemonic string, opts ...SuggestOption) (*Suggestions, error) {
+	words, _ := SplitMnemonic(mnemonic)
+	var known [languageCount]int
+	for _, word := range words {
+		set := registry().words[word]
+		for l := range Language(languageCount) {
+			if set.has(l) {
+				known[l]++
+			}
+		}
+	}
+	var language Language
+	best := 0
+	for l, count := range known {
+		if count > best {
+			language, best = Language(l), count
+		}
+	}
+	if best == 0 {
+		return nil, fmt.Errorf("%w: no word of any language", ErrInvalidMnemonic)
+	}
+	m, err := NewMnemonic(WithLanguage(language))
+	if err != nil {
+		return nil, err
+	}
+	return m.Suggest(mnemonic, opts...)
+}
+
+// Suggest suggests corrections for the words of the mnemonic that are not in the wordlist.
+// With WithPrefixes() option, unique prefixes are not considered unknown.
+//
+// The candidates of each unknown word are ranked by distance, up to 5 candidates at a distance of at most 2.
+// If you want to change them, use WithMaxCandidates() and WithMaxDistance() options.
+//
+// If the mnemonic has a valid number of words, every combination of candidates is checked against the checksum,
+// as long as there are at most 65536 of them. If exactly one combination is valid, it is returned as Mnemonic.
+func (m *Mnemonic) Suggest(mnemonic string, opts ...SuggestOption) (*Suggestions, error) {
+	options := &SuggestOptions{
+		maxCandidates: defaultMaxCandidates,
+		maxDistance:   defaultMaxDistance,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	words, _ := SplitMnemonic(mnemonic)
+	suggestions := &Suggestions{Language: m.language}
+	for i, word := range words {
+		if _, ok := m.wordMap[word]; ok {
+			continue
+		}
+		if m.allowPrefixes {
+			if candidates := lookupPrefix(m.wordMap, m.index, word); len(candidates) == 1 {
+				words[i] = candidates[0]
+				continue
+			}
+		}
+		suggestions.Words = append(suggestions.Words, WordSuggestion{
+			Position:   i,
+			Word:       word,
+			Candidates: m.candidates(word, options),
+		})
+	}
+	if len(suggestions.Words) == 0 || !isValidWordsSize(len(words)) {
+		return suggestions, nil
+	}
+
+	combinations := 1
+	for _, word := range suggestions.Words {
+		combinations *= len(word.Candidates)
+		if combinations == 0 || combinations > maxSuggestCombinations {
+			return suggestions, nil
+		}
+	}
+	// Enumerate the combinations like a number whose digits are the candidates of each unknown word.
+	digits := make([]int, len(suggestions.Words))
+	var buf [maxEntropyWithChecksumSize]byte
+	for range combinations {
+		for i, word := range suggestions.Words {
+			words[word.Position] = word.Candidates[digits[i]].Word
+		}
+		if checkWords(m.wordMap, words, buf[:]) == nil {
+			suggestions.Matches++
+			suggestions.Mnemonic = strings.Join(words, m.delimiter)
+		}
+		for i := range digits {
+			digits[i]++
+			if digits[i] < len(suggestions.Words[i].Candidates) {
+				break
+			}
+			digits[i] = 0
+		}
+	}
+	if suggestions.Matches != 1 {
+		suggestions.Mnemonic = ""
+	}
+	return suggestions, nil
+}
+
+// candidates returns the words of the wordlist closest to word.
+func (m *Mnemonic) candidates(word string, options *SuggestOptions) []Candidate {
+	typed := []rune(strings.ToLower(word))
+	var candidates []Candidate
+	for _, candidate := range m.index.wordlist {
+		distance := editDistance(typed, []rune(candidate))
+		if distance <= options.maxDistance {
+			candidates = append(candidates, Candidate{Word: candidate, Distance: distance})
+		}
+	}
+	// The sort is stable so that candidates at the same distance stay in the order of the wordlist.
+	slices.SortStableFunc(candidates, func(a, b Candidate) int {
+		switch {
+		case a.Distance < b.Distance:
+			return -1
+		case a.Distance > b.Distance:
+			return 1
+		}
+		return 0
+	})
+	if len(candidates) > options.maxCandidates {
+		candidates = candidates[:options.maxCandidates]
+	}
+	return candidates
+}
+
+// editDistance returns the optimal string alignment distance between a and b,
+// where the substitution of adjacent keys costs half an edit.
+func editDistance(a, b []rune) float64 {
+	// d[i][j] is the distance between a[:i] and b[:j].
+	d := make([][]float64, len(a)+1)
+	for i := range d {
+		d[i] = make([]float64, len(b)+1)
+		d[i][0] = float64(i)
+	}
+	for j := range d[0] {
+		d[0][j] = float64(j)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			substitution := 0.0
+			if a[i-1] != b[j-1] {
+				substitution = 1
+				if isAdjacentKey(a[i-1], b[j-1]) {
+					substitution = 0.5
+				}
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+substitution)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+// keyboardRows are the letter rows of a QWERTY keyboard.
+var keyboardRows = []string{"qwertyuiop", "asdfghjkl", "zxcvbnm"}
+
+// isAdjacentKey reports whether the keys of a and b touch each other on a QWERTY keyboard.
+func isAdjacentKey(a, b rune) bool {
+	rowA, colA := keyPosition(a)
+	rowB, colB := keyPosition(b)
+	if rowA < 0 || rowB < 0 {
+		return false
+	}
+	switch rowB - rowA {
+	case 0:
+		return colB-colA == 1 || colA-colB == 1
+	case 1:
+		// Each row is shifted right by half a key, so a key touches the keys below it at the same and the previous column.
+		return colB == colA || colB == colA-1
+	case -1:
+		return colA == colB || colA == colB-1
+	}
+	return false
+}
+
+// keyPosition returns the row and column of the key of r on a QWERTY keyboard, or -1 if there is none.
+func keyPosition(r rune) (row, col int) {
+	for row, keys := range keyboardRows {
+		if col := strings.IndexRune(keys, r); col >= 0 {
+			return row, col
+		}
+	}
+	return -1, -1
+}
@@ -0,0 +1,92 @@
+package bip39
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSuggest(t *testing.T) {
+	// "sausage" with swapped letters and "worth" with a neighboring key.
+	suggestions, err := Suggest("legal winner thank year wave sausgae wirth useful legal winner thank yellow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if suggestions.Language != English || len(suggestions.Words) != 2 {
+		t.Fatal("invalid suggestions", suggestions)
+	}
+	first, second := suggestions.Words[0], suggestions.Words[1]
+	if first.Position != 5 || first.Word != "sausgae" || first.Candidates[0].Word != "sausage" || first.Candidates[0].Distance != 1 {
+		t.Fatal("invalid suggestion", first)
+	}
+	// "width" is as close as "worth", the checksum tells them apart.
+	if second.Position != 6 || len(second.Candidates) < 2 || second.Candidates[0].Word != "width" || second.Candidates[1].Word != "worth" || second.Candidates[1].Distance != 0.5 {
+		t.Fatal("invalid suggestion", second)
+	}
+	if suggestions.Matches != 1 || suggestions.Mnemonic != "legal winner thank year wave sausage worth useful legal winner thank yellow" {
+		t.Fatal("invalid mnemonic", suggestions.Matches, suggestions.Mnemonic)
+	}
+
+	// Without a valid number of words, the checksum is not checked.
+	suggestions, err = Suggest("legal winner thank yeat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(suggestions.Words) != 1 || suggestions.Words[0].Candidates[0].Word != "year" || suggestions.Matches != 0 || suggestions.Mnemonic != "" {
+		t.Fatal("invalid suggestions", suggestions)
+	}
+
+	if _, err = Suggest("xxxxxx yyyyyy"); !errors.Is(err, ErrInvalidMnemonic) {
+		t.Fatal("invalid error", err)
+	}
+}
+
+func TestSuggestOptions(t *testing.T) {
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	suggestions, err := m.Suggest("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abuot", WithMaxCandidates(2), WithMaxDistance(1.5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	candidates := suggestions.Words[0].Candidates
+	if len(candidates) != 2 || candidates[0].Word != "about" || candidates[1].Distance > 1.5 {
+		t.Fatal("invalid candidates", candidates)
+	}
+
+	// Unique prefixes are not unknown words with WithPrefixes option.
+	m, err = NewMnemonic(WithPrefixes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	suggestions, err = m.Suggest("lega winn than year wave sausgae wort usef lega winn than yell")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(suggestions.Words) != 1 || suggestions.Mnemonic != "legal winner thank year wave sausage worth useful legal winner thank yellow" {
+		t.Fatal("invalid suggestions", suggestions)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		distance float64
+	}{
+		{"about", "about", 0},
+		{"abuot", "about", 1},
+		{"abot", "about", 1},
+		{"abiut", "about", 0.5},
+		{"abpit", "about", 1},
+		{"abut", "about", 1},
+		{"", "about", 5},
+	}
+	for _, test := range tests {
+		if d := editDistance([]rune(test.a), []rune(test.b)); d != test.distance {
+			t.Fatalf("%s %s: invalid distance %v", test.a, test.b, d)
+		}
+	}
+	if !isAdjacentKey('g', 'h') || !isAdjacentKey('s', 'w') || !isAdjacentKey('x', 's') || isAdjacentKey('a', 'p') || isAdjacentKey('x', 'w') || isAdjacentKey('é', 'e') {
+		t.Fatal("invalid adjacent keys")
+	}
+}
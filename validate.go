@@ -0,0 +1,77 @@
+package bip39
+
+import "errors"
+
+// Validation is the result of Validate.
+type Validation struct {
+	// Languages are the results for each language detected by DetectLanguage, in ascending order.
+	// It is empty if no language has the first words of the mnemonic: detection stops once they leave a single
+	// language, and the words after them are checked by its result only.
+	Languages []LanguageValidation
+}
+
+// LanguageValidation is the result of Validate for a language.
+type LanguageValidation struct {
+	Language Language
+	// WordsValid reports whether the number of words is valid and every word is a word of the language.
+	WordsValid bool
+	// ChecksumValid reports whether the checksum of the words is valid. It implies WordsValid.
+	ChecksumValid bool
+	// Entropy is the entropy of the mnemonic if ChecksumValid, nil otherwise.
+	Entropy []byte
+	// Err is the first error found, as returned by EntropyFromMnemonic, nil if ChecksumValid.
+	Err error
+}
+
+// Valid reports whether the mnemonic is valid in at least one language.
+func (v *Validation) Valid() bool {
+	return len(v.ValidLanguages()) > 0
+}
+
+// ValidLanguages returns the languages in which the mnemonic is valid, in ascending order.
+func (v *Validation) ValidLanguages() []Language {
+	var languages []Language
+	for _, result := range v.Languages {
+		if result.ChecksumValid {
+			languages = append(languages, result.Language)
+		}
+	}
+	return languages
+}
+
+// Validate checks the mnemonic in each language detected by DetectLanguage with the options,
+// and returns the result for each of them.
+//
+// A mnemonic made of words shared by several languages, such as the 100 words English and French share,
+// may be valid in one of them and fail the checksum in the others, where the words have other indexes.
+// With WithPrefixDetection() option, the words may be unique prefixes.
+//
+// Example:
+//
+//	validation := Validate("abandon amateur angle animal aspect badge bicycle bonus brave canal capable abandon")
+//	for _, result := range validation.Languages {
+//		fmt.Println(result.Language, result.ChecksumValid, result.Err) // english true <nil>, then french false checksum incorrect in french
+//	}
+func Validate(mnemonic string, opts ...DetectLanguageOption) *Validation {
+	options := &DetectLanguageOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	validation := &Validation{}
+	for _, language := range detectLanguages(mnemonic, options).list() {
+		newOpts := []NewMnemonicOption{WithLanguage(language)}
+		if options.prefixes {
+			newOpts = append(newOpts, WithPrefixes())
+		}
+		result := LanguageValidation{Language: language}
+		m, err := NewMnemonic(newOpts...)
+		if err == nil {
+			result.Entropy, err = m.EntropyFromMnemonic(mnemonic)
+		}
+		result.Err = err
+		result.ChecksumValid = err == nil
+		result.WordsValid = err == nil || errors.Is(err, ErrChecksumIncorrect)
+		validation.Languages = append(validation.Languages, result)
+	}
+	return validation
+}
@@ -0,0 +1,108 @@
+package bip39
+
+import (
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestValidate(t *testing.T) {
+	// The words are both English and French, with other indexes in French.
+	const shared = "abandon amateur angle animal aspect badge bicycle bonus brave canal capable abandon"
+	validation := Validate(shared)
+	if len(validation.Languages) != 2 || !validation.Valid() || !slices.Equal(validation.ValidLanguages(), []Language{English}) {
+		t.Fatalf("invalid validation %+v", validation.Languages)
+	}
+	english, french := validation.Languages[0], validation.Languages[1]
+	if english.Language != English || !english.WordsValid || !english.ChecksumValid || english.Err != nil || len(english.Entropy) != 16 {
+		t.Fatalf("invalid english result %+v", english)
+	}
+	if french.Language != French || !french.WordsValid || french.ChecksumValid || !errors.Is(french.Err, ErrChecksumIncorrect) || french.Entropy != nil {
+		t.Fatalf("invalid french result %+v", french)
+	}
+	if !IsMnemonicValid(shared) {
+		t.Fatal("mnemonic valid in English")
+	}
+
+	validation = Validate("legal winner thank year wave sausage worth useful legal winner thank yellow")
+	if len(validation.Languages) != 1 || hex.EncodeToString(validation.Languages[0].Entropy) != "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f" {
+		t.Fatalf("invalid validation %+v", validation.Languages)
+	}
+
+	validation = Validate("lega winn than year wave saus wort usef lega winn than yell", WithPrefixDetection())
+	if !slices.Equal(validation.ValidLanguages(), []Language{English}) {
+		t.Fatalf("invalid validation %+v", validation.Languages)
+	}
+
+	tests := []struct {
+		mnemonic   string
+		wordsValid bool
+		err        error
+	}{
+		{"legal winner thank year wave sausage worth useful legal winner thank year", true, ErrChecksumIncorrect},
+		{"legal winner thank year wave sausage worth useful legal winner thank", false, ErrInvalidNumberWords},
+		// The language is detected from the first words, the unknown word is reported by the validation.
+		{"legal winner thank year wave sausage worth useful legal winner thank zzz", false, ErrInvalidMnemonic},
+	}
+	for _, test := range tests {
+		validation := Validate(test.mnemonic)
+		if validation.Valid() || IsMnemonicValid(test.mnemonic) {
+			t.Fatalf("%s: unexpected valid", test.mnemonic)
+		}
+		for _, result := range validation.Languages {
+			if result.WordsValid != test.wordsValid || result.ChecksumValid || !errors.Is(result.Err, test.err) {
+				t.Fatalf("%s: invalid result %+v", test.mnemonic, result)
+			}
+		}
+	}
+	if validation := Validate("zzz zzz zzz"); len(validation.Languages) != 0 || validation.Valid() {
+		t.Fatalf("invalid validation %+v", validation.Languages)
+	}
+}
+
+func TestValidateIsMnemonicValid(t *testing.T) {
+	const english = "legal winner thank year wave sausage worth useful legal winner thank yellow"
+	mnemonics := []string{
+		"",
+		"   ",
+		"\u3000",
+		english,
+		"\t" + english + "\n",
+		"\u3000" + english + "\u3000",
+		strings.ReplaceAll(english, " ", "\u3000"),
+		strings.ToUpper(english),
+		"legal winner thank year wave sausage worth useful legal winner thank",
+		english + " yellow",
+		strings.Repeat("legal ", 24) + "legal",
+		"legal winner thank year wave sausage worth useful legal winner thank year",
+		"legal winner thank year wave sausage worth useful legal winner thank zzz",
+		// The first words are English only, the last one is French only.
+		"legal winner thank year wave sausage worth useful legal winner thank abaisser",
+		"abandon amateur angle animal aspect badge bicycle bonus brave canal capable abandon",
+	}
+	entropy := make([]byte, 32)
+	for i := range entropy {
+		entropy[i] = byte(i * 7)
+	}
+	for language := range Language(languageCount) {
+		m, err := NewMnemonic(WithLanguage(language))
+		if err != nil {
+			t.Fatal(err)
+		}
+		mnemonic, err := m.EntropyToMnemonic(entropy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		words, _ := SplitMnemonic(mnemonic)
+		mnemonics = append(mnemonics, mnemonic, norm.NFC.String(mnemonic), strings.Join(words, " "), strings.Join(words[:len(words)-1], " "))
+	}
+	for _, mnemonic := range mnemonics {
+		if IsMnemonicValid(mnemonic) != Validate(mnemonic).Valid() {
+			t.Fatalf("%q: IsMnemonicValid %v, Validate %v", mnemonic, IsMnemonicValid(mnemonic), Validate(mnemonic).Valid())
+		}
+	}
+}
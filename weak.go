@@ -0,0 +1,293 @@
+package bip39
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+)
+
+// RiskLevel is how easily a mnemonic may be guessed by others.
+type RiskLevel int
+
+const (
+	// RiskNone is the level of a mnemonic without any weakness found.
+	RiskNone RiskLevel = iota
+	// RiskLow is the level of an unusual mnemonic that random entropy produces now and then.
+	RiskLow
+	// RiskHigh is the level of a mnemonic that random entropy almost never produces, likely chosen by hand.
+	RiskHigh
+	// RiskCritical is the level of a published mnemonic, whose funds are swept as soon as they arrive.
+	RiskCritical
+)
+
+func (l RiskLevel) String() string {
+	switch l {
+	case RiskNone:
+		return "none"
+	case RiskLow:
+		return "low"
+	case RiskHigh:
+		return "high"
+	case RiskCritical:
+		return "critical"
+	}
+	return "unknown"
+}
+
+// Weakness is a kind of weakness found by AssessRisk.
+type Weakness int
+
+const (
+	// KnownMnemonic is a mnemonic published as a test vector or an example, such as "abandon abandon ... about".
+	KnownMnemonic Weakness = iota + 1
+	// RepeatedWords is a word occurring 3 or more times.
+	RepeatedWords
+	// SequentialWords are consecutive words following each other in the wordlist, or at a constant step.
+	SequentialWords
+	// LowEntropy is entropy failing a health test of CheckEntropyHealth, or words repeating a pattern.
+	LowEntropy
+)
+
+func (w Weakness) String() string {
+	switch w {
+	case KnownMnemonic:
+		return "known mnemonic"
+	case RepeatedWords:
+		return "repeated words"
+	case SequentialWords:
+		return "sequential words"
+	case LowEntropy:
+		return "low entropy"
+	}
+	return "unknown"
+}
+
+// RiskReport is the result of AssessRisk.
+type RiskReport struct {
+	// Level is the highest level of the findings, RiskNone if there is none.
+	Level RiskLevel
+	// Findings are the weaknesses found, in the order of Weakness.
+	Findings []Finding
+}
+
+// Finding is a weakness found in a mnemonic.
+type Finding struct {
+	Weakness Weakness
+	Level    RiskLevel
+	// Positions are the indexes of the words involved, starting at 0, or nil if the whole mnemonic is.
+	Positions []int
+	// Detail describes the weakness, such as `"abandon" occurs 11 times`.
+	Detail string
+}
+
+func (r *RiskReport) add(finding Finding) {
+	r.Findings = append(r.Findings, finding)
+	r.Level = max(r.Level, finding.Level)
+}
+
+// knownEntropies are the SHA256 hashes of the entropies of published mnemonics, with where they were published.
+// Only the hashes are kept, so that the list is not another list of mnemonics to copy.
+var knownEntropies = map[string]string{
+	// https://github.com/trezor/python-mnemonic/blob/master/vectors.json
+	"374708fff7719dd5979ec875d56cd2286f6d3cf7ec317a3b25632aab28ec37bb": "a BIP39 test vector",
+	"87dcde7fa6df23e15fa7ba9b2a1f31408eac832f4e615ea815ae92024e3d818b": "a BIP39 test vector",
+	"4e972baaee2ad54f78153134ef6484cd1a8e383d21582a21a481d4d214161916": "a BIP39 test vector",
+	"5ac6a5945f16500911219129984ba8b387a06f24fe383ce4e81a73294065461b": "a BIP39 test vector",
+	"9d908ecfb6b256def8b49a7c504e6c889c4b0e41fe6ce3e01863dd7b61a20aa0": "a BIP39 test vector",
+	"66682ab64f5c5ad41e883cb68bc79d364603ba8bed9f92b77b75981c95b348ee": "a BIP39 test vector",
+	"f0f7c775cc67fa28506475b93aaa2af10a9ff92211f8014f35a6f642a6a8fe2e": "a BIP39 test vector",
+	"44a5f7891570e5631e8c91c85186e6633f4ab5364f644040b2a00126a07985b6": "a BIP39 test vector",
+	"66687aadf862bd776c8fc18b8e9f8e20089714856ee233b3902a591d0d5f2925": "a BIP39 test vector",
+	"17a7384bf1c50a94b712ce507c5ccd58638cd091278078c6e7dc00ae0aa152fc": "a BIP39 test vector",
+	"bd75a82b9957d6d043076dea52262635042693f1fe23bcadadaecc908e1e5cc6": "a BIP39 test vector",
+	"af9613760f72635fbdb44a5a0a63c39f12af30f950a6ee5c971be188e89c4051": "a BIP39 test vector",
+	"1405e8392df14cd02b976d618b57e67be7ad31e071c410cabc849966892bf9cc": "a BIP39 test vector",
+	"49b7719c0dff6d00c7a2c9c51083ca04100e9101560851de6fe32d37d7b615be": "a BIP39 test vector",
+	"005b30a3ab171b8c3bed01ad5e340de09c0efab48ec02a82bac2081139d18663": "a BIP39 test vector",
+	"074ae1f8e7121aebd15c9070144c78538c18b393a00d08e2f0856a2474ff943c": "a BIP39 test vector",
+	"68ba3c169969c3fa5071239bcab48a1ce542d573b60be09b5434e5a70269ee20": "a BIP39 test vector",
+	"a98ed335f345b0a81195f4749340880c781377dc10cd8880dba43fb49264455f": "a BIP39 test vector",
+	"a6827c38e3c7f802248557b77848536ccaf1a446e14696c4e91f02e3825df022": "a BIP39 test vector",
+	"29a8b34689f15991da2c5e2bd4511f0eeadc30f7ed31525aa7332f0a11097ebe": "a BIP39 test vector",
+	"a209c1acb3397f89bafa9f81bcbb98484527832549d460f85c22f6eb062b352a": "a BIP39 test vector",
+	"2165f0aafe44e727b2bdb6afec8cb5cd363a5f1d6ce7ac44d6e60562d2f14bce": "a BIP39 test vector",
+	"8bfe0b56d42ae41677cc263f230cd45b7cfc651b19a7ffdd33cdb421647b321b": "a BIP39 test vector",
+	"69b6509a79cef59522ec39b476831275e01e89b0af4697497a2d11bb1d4477bf": "a BIP39 test vector",
+	// Default mnemonics of Ethereum development tools, shown in countless tutorials.
+	"a13b27648ff45bcf53d78bba1170226871a88a2cae91754c0c1e31a087b169d3": "the Hardhat and Foundry default mnemonic",
+	"eff8ddfae2b78af227bc199f194c0a81546ea157158db737573cf4977422d646": "the Truffle Develop default mnemonic",
+	"c60dab48e48562856d784f96190be55a866600eb800defcfad2af3f8bd05e897": "the ganache-cli --deterministic mnemonic",
+}
+
+// knownEntropySource returns where the entropy of a published mnemonic was published.
+func knownEntropySource(entropy []byte) (string, bool) {
+	hash := sha256.Sum256(entropy)
+	source, ok := knownEntropies[hex.EncodeToString(hash[:])]
+	return source, ok
+}
+
+const (
+	// minRepeatedWords is the number of occurrences of a word that makes a RepeatedWords finding.
+	minRepeatedWords = 3
+	// highRepeatedWords is the number of occurrences of a word, or of repetitions in a row, that makes it RiskHigh.
+	highRepeatedWords = 4
+	// minSequentialWords is the length of a run of words following each other in the wordlist that makes a SequentialWords finding.
+	minSequentialWords = 3
+	// minSteppedWords is the length of a run of words at another constant step that makes a SequentialWords finding.
+	minSteppedWords = 4
+)
+
+// AssessRisk checks a mnemonic for weaknesses that make it guessable, and returns a risk report.
+// The mnemonic must be valid, it is converted with EntropyFromMnemonic first.
+//
+// A mnemonic generated from random entropy is safe, whatever its words. But people pick words by hand,
+// copy examples or test vectors, or use broken generators, and such mnemonics are swept by bots.
+// The entropy is looked up in a built-in set of published mnemonics, kept as SHA256 hashes,
+// and the words are checked for repetitions, sequences of the wordlist, and low entropy patterns.
+// RiskLow findings are left to judgement, as about 1 in 2000 random 24 words mnemonics has a word 3 times.
+//
+// Example:
+//
+//	report, err := m.AssessRisk("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+//	fmt.Println(report.Level) // critical
+func (m *Mnemonic) AssessRisk(mnemonic string) (*RiskReport, error) {
+	entropy, err := m.EntropyFromMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	words, _ := SplitMnemonic(mnemonic)
+	if m.allowPrefixes {
+		if words, err = expandPrefixes(m.wordMap, m.index, words); err != nil {
+			return nil, err
+		}
+	}
+	indexes := make([]int, len(words))
+	for i, word := range words {
+		indexes[i] = m.wordMap[word]
+	}
+
+	report := &RiskReport{}
+	if source, ok := knownEntropySource(entropy); ok {
+		report.add(Finding{
+			Weakness: KnownMnemonic,
+			Level:    RiskCritical,
+			Detail:   "the mnemonic of " + source,
+		})
+	}
+	repeatedWords(report, words)
+	sequentialWords(report, indexes)
+	lowEntropy(report, entropy, indexes)
+	return report, nil
+}
+
+// repeatedWords reports the words occurring at least minRepeatedWords times, in the order of their first occurrence.
+func repeatedWords(report *RiskReport, words []string) {
+	for i, word := range words {
+		if slices.Index(words, word) < i {
+			continue
+		}
+		var positions []int
+		run, longestRun := 0, 0
+		for j := i; j < len(words); j++ {
+			if words[j] != word {
+				run = 0
+				continue
+			}
+			positions = append(positions, j)
+			run++
+			longestRun = max(longestRun, run)
+		}
+		if len(positions) < minRepeatedWords {
+			continue
+		}
+		level := RiskLow
+		if len(positions) >= highRepeatedWords || longestRun >= minRepeatedWords {
+			level = RiskHigh
+		}
+		report.add(Finding{
+			Weakness:  RepeatedWords,
+			Level:     level,
+			Positions: positions,
+			Detail:    fmt.Sprintf("%q occurs %d times", word, len(positions)),
+		})
+	}
+}
+
+// sequentialWords reports the longest runs of words whose indexes change by the same nonzero step,
+// at least minSequentialWords words for a step of 1 or -1 and minSteppedWords words for other steps.
+func sequentialWords(report *RiskReport, indexes []int) {
+	for start := 0; start < len(indexes)-1; {
+		step := indexes[start+1] - indexes[start]
+		end := start + 2
+		for end < len(indexes) && indexes[end]-indexes[end-1] == step {
+			end++
+		}
+		length := end - start
+		minLength := minSteppedWords
+		if step == 1 || step == -1 {
+			minLength = minSequentialWords
+		}
+		if step != 0 && length >= minLength {
+			var detail string
+			switch {
+			case step == 1:
+				detail = fmt.Sprintf("words %d to %d follow each other in the wordlist", start+1, end)
+			case step == -1:
+				detail = fmt.Sprintf("words %d to %d follow each other backwards in the wordlist", start+1, end)
+			default:
+				detail = fmt.Sprintf("words %d to %d are %d words apart in the wordlist", start+1, end, max(step, -step))
+			}
+			report.add(Finding{
+				Weakness:  SequentialWords,
+				Level:     RiskHigh,
+				Positions: positionsBetween(start, end),
+				Detail:    detail,
+			})
+		}
+		// The last word of a run may start the next one.
+		start = end - 1
+	}
+}
+
+// lowEntropy reports entropy failing the health tests of CheckEntropyHealth, and words repeating a pattern.
+func lowEntropy(report *RiskReport, entropy []byte, indexes []int) {
+	var failed HealthTest
+	switch {
+	case !repetitionCountTest(entropy):
+		failed = RepetitionCountTest
+	case !adaptiveProportionTest(entropy):
+		failed = AdaptiveProportionTest
+	case hasRepeatingPattern(entropy):
+		failed = RepeatingPatternTest
+	}
+	if failed != 0 {
+		report.add(Finding{
+			Weakness: LowEntropy,
+			Level:    RiskHigh,
+			Detail:   fmt.Sprintf("the entropy failed the %s test", failed),
+		})
+	}
+	// The last word holds the checksum, which does not follow the pattern.
+	words := indexes[:len(indexes)-1]
+	for period := 1; period <= len(words)/2; period++ {
+		if slices.Equal(words[period:], words[:len(words)-period]) {
+			report.add(Finding{
+				Weakness:  LowEntropy,
+				Level:     RiskHigh,
+				Positions: positionsBetween(0, len(words)),
+				Detail:    fmt.Sprintf("the words repeat a pattern of %d words", period),
+			})
+			break
+		}
+	}
+}
+
+// positionsBetween returns the positions from start to end, excluded.
+func positionsBetween(start, end int) []int {
+	positions := make([]int, 0, end-start)
+	for i := start; i < end; i++ {
+		positions = append(positions, i)
+	}
+	return positions
+}
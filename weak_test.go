@@ -0,0 +1,127 @@
+package bip39
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestAssessRisk(t *testing.T) {
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		mnemonic   string
+		level      RiskLevel
+		weaknesses []Weakness
+	}{
+		{"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", RiskCritical, []Weakness{KnownMnemonic, RepeatedWords, LowEntropy, LowEntropy}},
+		{"test test test test test test test test test test test junk", RiskCritical, []Weakness{KnownMnemonic, RepeatedWords, LowEntropy}},
+		{"candy maple cake sugar pudding cream honey rich smooth crumble sweet treat", RiskCritical, []Weakness{KnownMnemonic}},
+		{"ozone drill grab fiber curtain grace pudding thank cruise elder eight picnic", RiskCritical, []Weakness{KnownMnemonic}},
+		{"assault novel believe crisp elder junior kitchen zone essence foam quit damage", RiskNone, nil},
+	}
+	for _, test := range tests {
+		report, err := m.AssessRisk(test.mnemonic)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var weaknesses []Weakness
+		for _, finding := range report.Findings {
+			weaknesses = append(weaknesses, finding.Weakness)
+		}
+		if report.Level != test.level || !slices.Equal(weaknesses, test.weaknesses) {
+			t.Fatalf("%s: invalid report %s %v", test.mnemonic, report.Level, report.Findings)
+		}
+	}
+
+	if _, err := m.AssessRisk("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon"); !errors.Is(err, ErrChecksumIncorrect) {
+		t.Fatalf("invalid error %v", err)
+	}
+}
+
+func TestKnownEntropies(t *testing.T) {
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		mnemonic string
+		source   string
+	}{
+		{"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "a BIP39 test vector"},
+		{"test test test test test test test test test test test junk", "the Hardhat and Foundry default mnemonic"},
+		{"candy maple cake sugar pudding cream honey rich smooth crumble sweet treat", "the Truffle Develop default mnemonic"},
+		{"myth like bonus scare over problem client lizard pioneer submit female collect", "the ganache-cli --deterministic mnemonic"},
+	}
+	for _, test := range tests {
+		entropy, err := m.EntropyFromMnemonic(test.mnemonic)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hash := sha256.Sum256(entropy)
+		if source := knownEntropies[hex.EncodeToString(hash[:])]; source != test.source {
+			t.Fatalf("%s: invalid source %q", test.mnemonic, source)
+		}
+	}
+}
+
+func TestAssessRiskHeuristics(t *testing.T) {
+	m, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		words     string
+		level     RiskLevel
+		weakness  Weakness
+		positions []int
+	}{
+		// The words of the wordlist in order.
+		{"abandon ability able crisp elder junior kitchen zone essence foam quit", RiskHigh, SequentialWords, []int{0, 1, 2}},
+		{"assault novel believe crisp elder junior able ability abandon foam quit", RiskHigh, SequentialWords, []int{6, 7, 8}},
+		// Every 16th word of the wordlist.
+		{"assault novel believe crisp elder junior kitchen about act afford all", RiskHigh, SequentialWords, []int{7, 8, 9, 10}},
+		{"assault novel assault crisp elder assault kitchen zone essence foam quit", RiskLow, RepeatedWords, []int{0, 2, 5}},
+		{"assault novel assault assault assault junior kitchen zone essence foam quit", RiskHigh, RepeatedWords, []int{0, 2, 3, 4}},
+		{"assault novel believe assault novel believe assault novel believe assault novel", RiskHigh, RepeatedWords, []int{0, 3, 6, 9}},
+	}
+	for _, test := range tests {
+		finalWords, err := m.FinalWords(test.words)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mnemonic := test.words + " " + finalWords[0].Word
+		report, err := m.AssessRisk(mnemonic)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if report.Level != test.level || len(report.Findings) == 0 {
+			t.Fatalf("%s: invalid report %s %v", mnemonic, report.Level, report.Findings)
+		}
+		finding := report.Findings[0]
+		if finding.Weakness != test.weakness || !slices.Equal(finding.Positions, test.positions) {
+			t.Fatalf("%s: invalid finding %v", mnemonic, finding)
+		}
+	}
+
+	// A pattern of words repeated is low entropy.
+	words := strings.Repeat("assault novel believe crisp elder junior ", 3)
+	finalWords, err := m.FinalWords(words + "assault novel believe crisp elder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := m.AssessRisk(words + "assault novel believe crisp elder " + finalWords[0].Word)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.ContainsFunc(report.Findings, func(f Finding) bool {
+		return f.Weakness == LowEntropy && f.Detail == "the words repeat a pattern of 6 words"
+	}) {
+		t.Fatalf("invalid findings %v", report.Findings)
+	}
+}
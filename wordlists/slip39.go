@@ -0,0 +1,1100 @@
+package wordlists
+
+// https://github.com/satoshilabs/slips/blob/master/slip-0039/wordlist.txt
+var (
+	// SLIP39 is the list of English words for the SLIP-0039 standard.
+	SLIP39 = []string{
+		"academic", "acid", "acne", "acquire", "acrobat", "activity", "actress", "adapt", "adequate", "adjust", "admit", "adorn", "adult", "advance", "advocate", "afraid",
+		"again", "agency", "agree", "aide", "aircraft", "airline", "airport", "ajar", "alarm", "album", "alcohol", "alien", "alive", "alpha", "already", "alto",
+		"aluminum", "always", "amazing", "ambition", "amount", "amuse", "analysis", "anatomy", "ancestor", "ancient", "angel", "angry", "animal", "answer", "antenna", "anxiety",
+		"apart", "aquatic", "arcade", "arena", "argue", "armed", "artist", "artwork", "aspect", "auction", "august", "aunt", "average", "aviation", "avoid", "award",
+		"away", "axis", "axle", "beam", "beard", "beaver", "become", "bedroom", "behavior", "being", "believe", "belong", "benefit", "best", "beyond", "bike",
+		"biology", "birthday", "bishop", "black", "blanket", "blessing", "blimp", "blind", "blue", "body", "bolt", "boring", "born", "both", "boundary", "bracelet",
+		"branch", "brave", "breathe", "briefing", "broken", "brother", "browser", "bucket", "budget", "building", "bulb", "bulge", "bumpy", "bundle", "burden", "burning",
+		"busy", "buyer", "cage", "calcium", "camera", "campus", "canyon", "capacity", "capital", "capture", "carbon", "cards", "careful", "cargo", "carpet", "carve",
+		"category", "cause", "ceiling", "center", "ceramic", "champion", "change", "charity", "check", "chemical", "chest", "chew", "chubby", "cinema", "civil", "class",
+		"clay", "cleanup", "client", "climate", "clinic", "clock", "clogs", "closet", "clothes", "club", "cluster", "coal", "coastal", "coding", "column", "company",
+		"corner", "costume", "counter", "course", "cover", "cowboy", "cradle", "craft", "crazy", "credit", "cricket", "criminal", "crisis", "critical", "crowd", "crucial",
+		"crunch", "crush", "crystal", "cubic", "cultural", "curious", "curly", "custody", "cylinder", "daisy", "damage", "dance", "darkness", "database", "daughter", "deadline",
+		"deal", "debris", "debut", "decent", "decision", "declare", "decorate", "decrease", "deliver", "demand", "density", "deny", "depart", "depend", "depict", "deploy",
+		"describe", "desert", "desire", "desktop", "destroy", "detailed", "detect", "device", "devote", "diagnose", "dictate", "diet", "dilemma", "diminish", "dining", "diploma",
+		"disaster", "discuss", "disease", "dish", "dismiss", "display", "distance", "dive", "divorce", "document", "domain", "domestic", "dominant", "dough", "downtown", "dragon",
+		"dramatic", "dream", "dress", "drift", "drink", "drove", "drug", "dryer", "duckling", "duke", "duration", "dwarf", "dynamic", "early", "earth", "easel",
+		"easy", "echo", "eclipse", "ecology", "edge", "editor", "educate", "either", "elbow", "elder", "election", "elegant", "element", "elephant", "elevator", "elite",
+		"else", "email", "emerald", "emission", "emperor", "emphasis", "employer", "empty", "ending", "endless", "endorse", "enemy", "energy", "enforce", "engage", "enjoy",
+		"enlarge", "entrance", "envelope", "envy", "epidemic", "episode", "equation", "equip", "eraser", "erode", "escape", "estate", "estimate", "evaluate", "evening", "evidence",
+		"evil", "evoke", "exact", "example", "exceed", "exchange", "exclude", "excuse", "execute", "exercise", "exhaust", "exotic", "expand", "expect", "explain", "express",
+		"extend", "extra", "eyebrow", "facility", "fact", "failure", "faint", "fake", "false", "family", "famous", "fancy", "fangs", "fantasy", "fatal", "fatigue",
+		"favorite", "fawn", "fiber", "fiction", "filter", "finance", "findings", "finger", "firefly", "firm", "fiscal", "fishing", "fitness", "flame", "flash", "flavor",
+		"flea", "flexible", "flip", "float", "floral", "fluff", "focus", "forbid", "force", "forecast", "forget", "formal", "fortune", "forward", "founder", "fraction",
+		"fragment", "frequent", "freshman", "friar", "fridge", "friendly", "frost", "froth", "frozen", "fumes", "funding", "furl", "fused", "galaxy", "game", "garbage",
+		"garden", "garlic", "gasoline", "gather", "general", "genius", "genre", "genuine", "geology", "gesture", "glad", "glance", "glasses", "glen", "glimpse", "goat",
+		"golden", "graduate", "grant", "grasp", "gravity", "gray", "greatest", "grief", "grill", "grin", "grocery", "gross", "group", "grownup", "grumpy", "guard",
+		"guest", "guilt", "guitar", "gums", "hairy", "hamster", "hand", "hanger", "harvest", "have", "havoc", "hawk", "hazard", "headset", "health", "hearing",
+		"heat", "helpful", "herald", "herd", "hesitate", "hobo", "holiday", "holy", "home", "hormone", "hospital", "hour", "huge", "human", "humidity", "hunting",
+		"husband", "hush", "husky", "hybrid", "idea", "identify", "idle", "image", "impact", "imply", "improve", "impulse", "include", "income", "increase", "index",
+		"indicate", "industry", "infant", "inform", "inherit", "injury", "inmate", "insect", "inside", "install", "intend", "intimate", "invasion", "involve", "iris", "island",
+		"isolate", "item", "ivory", "jacket", "jerky", "jewelry", "join", "judicial", "juice", "jump", "junction", "junior", "junk", "jury", "justice", "kernel",
+		"keyboard", "kidney", "kind", "kitchen", "knife", "knit", "laden", "ladle", "ladybug", "lair", "lamp", "language", "large", "laser", "laundry", "lawsuit",
+		"leader", "leaf", "learn", "leaves", "lecture", "legal", "legend", "legs", "lend", "length", "level", "liberty", "library", "license", "lift", "likely",
+		"lilac", "lily", "lips", "liquid", "listen", "literary", "living", "lizard", "loan", "lobe", "location", "losing", "loud", "loyalty", "luck", "lunar",
+		"lunch", "lungs", "luxury", "lying", "lyrics", "machine", "magazine", "maiden", "mailman", "main", "makeup", "making", "mama", "manager", "mandate", "mansion",
+		"manual", "marathon", "march", "market", "marvel", "mason", "material", "math", "maximum", "mayor", "meaning", "medal", "medical", "member", "memory", "mental",
+		"merchant", "merit", "method", "metric", "midst", "mild", "military", "mineral", "minister", "miracle", "mixed", "mixture", "mobile", "modern", "modify", "moisture",
+		"moment", "morning", "mortgage", "mother", "mountain", "mouse", "move", "much", "mule", "multiple", "muscle", "museum", "music", "mustang", "nail", "national",
+		"necklace", "negative", "nervous", "network", "news", "nuclear", "numb", "numerous", "nylon", "oasis", "obesity", "object", "observe", "obtain", "ocean", "often",
+		"olympic", "omit", "oral", "orange", "orbit", "order", "ordinary", "organize", "ounce", "oven", "overall", "owner", "paces", "pacific", "package", "paid",
+		"painting", "pajamas", "pancake", "pants", "papa", "paper", "parcel", "parking", "party", "patent", "patrol", "payment", "payroll", "peaceful", "peanut", "peasant",
+		"pecan", "penalty", "pencil", "percent", "perfect", "permit", "petition", "phantom", "pharmacy", "photo", "phrase", "physics", "pickup", "picture", "piece", "pile",
+		"pink", "pipeline", "pistol", "pitch", "plains", "plan", "plastic", "platform", "playoff", "pleasure", "plot", "plunge", "practice", "prayer", "preach", "predator",
+		"pregnant", "premium", "prepare", "presence", "prevent", "priest", "primary", "priority", "prisoner", "privacy", "prize", "problem", "process", "profile", "program", "promise",
+		"prospect", "provide", "prune", "public", "pulse", "pumps", "punish", "puny", "pupal", "purchase", "purple", "python", "quantity", "quarter", "quick", "quiet",
+		"race", "racism", "radar", "railroad", "rainbow", "raisin", "random", "ranked", "rapids", "raspy", "reaction", "realize", "rebound", "rebuild", "recall", "receiver",
+		"recover", "regret", "regular", "reject", "relate", "remember", "remind", "remove", "render", "repair", "repeat", "replace", "require", "rescue", "research", "resident",
+		"response", "result", "retailer", "retreat", "reunion", "revenue", "review", "reward", "rhyme", "rhythm", "rich", "rival", "river", "robin", "rocky", "romantic",
+		"romp", "roster", "round", "royal", "ruin", "ruler", "rumor", "sack", "safari", "salary", "salon", "salt", "satisfy", "satoshi", "saver", "says",
+		"scandal", "scared", "scatter", "scene", "scholar", "science", "scout", "scramble", "screw", "script", "scroll", "seafood", "season", "secret", "security", "segment",
+		"senior", "shadow", "shaft", "shame", "shaped", "sharp", "shelter", "sheriff", "short", "should", "shrimp", "sidewalk", "silent", "silver", "similar", "simple",
+		"single", "sister", "skin", "skunk", "slap", "slavery", "sled", "slice", "slim", "slow", "slush", "smart", "smear", "smell", "smirk", "smith",
+		"smoking", "smug", "snake", "snapshot", "sniff", "society", "software", "soldier", "solution", "soul", "source", "space", "spark", "speak", "species", "spelling",
+		"spend", "spew", "spider", "spill", "spine", "spirit", "spit", "spray", "sprinkle", "square", "squeeze", "stadium", "staff", "standard", "starting", "station",
+		"stay", "steady", "step", "stick", "stilt", "story", "strategy", "strike", "style", "subject", "submit", "sugar", "suitable", "sunlight", "superior", "surface",
+		"surprise", "survive", "sweater", "swimming", "swing", "switch", "symbolic", "sympathy", "syndrome", "system", "tackle", "tactics", "tadpole", "talent", "task", "taste",
+		"taught", "taxi", "teacher", "teammate", "teaspoon", "temple", "tenant", "tendency", "tension", "terminal", "testify", "texture", "thank", "that", "theater", "theory",
+		"therapy", "thorn", "threaten", "thumb", "thunder", "ticket", "tidy", "timber", "timely", "ting", "tofu", "together", "tolerate", "total", "toxic", "tracks",
+		"traffic", "training", "transfer", "trash", "traveler", "treat", "trend", "trial", "tricycle", "trip", "triumph", "trouble", "true", "trust", "twice", "twin",
+		"type", "typical", "ugly", "ultimate", "umbrella", "uncover", "undergo", "unfair", "unfold", "unhappy", "union", "universe", "unkind", "unknown", "unusual", "unwrap",
+		"upgrade", "upstairs", "username", "usher", "usual", "valid", "valuable", "vampire", "vanish", "various", "vegan", "velvet", "venture", "verdict", "verify", "very",
+		"veteran", "vexed", "victim", "video", "view", "vintage", "violence", "viral", "visitor", "visual", "vitamins", "vocal", "voice", "volume", "voter", "voting",
+		"walnut", "warmth", "warn", "watch", "wavy", "wealthy", "weapon", "webcam", "welcome", "welfare", "western", "width", "wildlife", "window", "wine", "wireless",
+		"wisdom", "withdraw", "wits", "wolf", "woman", "work", "worthy", "wrap", "wrist", "writing", "wrote", "year", "yelp", "yield", "yoga", "zero",
+	}
+
+	// SLIP39Map is a map of SLIP-0039 words to their index in the list.
+	SLIP39Map = map[string]int{
+		"academic": 0,
+		"acid":     1,
+		"acne":     2,
+		"acquire":  3,
+		"acrobat":  4,
+		"activity": 5,
+		"actress":  6,
+		"adapt":    7,
+		"adequate": 8,
+		"adjust":   9,
+		"admit":    10,
+		"adorn":    11,
+		"adult":    12,
+		"advance":  13,
+		"advocate": 14,
+		"afraid":   15,
+		"again":    16,
+		"agency":   17,
+		"agree":    18,
+		"aide":     19,
+		"aircraft": 20,
+		"airline":  21,
+		"airport":  22,
+		"ajar":     23,
+		"alarm":    24,
+		"album":    25,
+		"alcohol":  26,
+		"alien":    27,
+		"alive":    28,
+		"alpha":    29,
+		"already":  30,
+		"alto":     31,
+		"aluminum": 32,
+		"always":   33,
+		"amazing":  34,
+		"ambition": 35,
+		"amount":   36,
+		"amuse":    37,
+		"analysis": 38,
+		"anatomy":  39,
+		"ancestor": 40,
+		"ancient":  41,
+		"angel":    42,
+		"angry":    43,
+		"animal":   44,
+		"answer":   45,
+		"antenna":  46,
+		"anxiety":  47,
+		"apart":    48,
+		"aquatic":  49,
+		"arcade":   50,
+		"arena":    51,
+		"argue":    52,
+		"armed":    53,
+		"artist":   54,
+		"artwork":  55,
+		"aspect":   56,
+		"auction":  57,
+		"august":   58,
+		"aunt":     59,
+		"average":  60,
+		"aviation": 61,
+		"avoid":    62,
+		"award":    63,
+		"away":     64,
+		"axis":     65,
+		"axle":     66,
+		"beam":     67,
+		"beard":    68,
+		"beaver":   69,
+		"become":   70,
+		"bedroom":  71,
+		"behavior": 72,
+		"being":    73,
+		"believe":  74,
+		"belong":   75,
+		"benefit":  76,
+		"best":     77,
+		"beyond":   78,
+		"bike":     79,
+		"biology":  80,
+		"birthday": 81,
+		"bishop":   82,
+		"black":    83,
+		"blanket":  84,
+		"blessing": 85,
+		"blimp":    86,
+		"blind":    87,
+		"blue":     88,
+		"body":     89,
+		"bolt":     90,
+		"boring":   91,
+		"born":     92,
+		"both":     93,
+		"boundary": 94,
+		"bracelet": 95,
+		"branch":   96,
+		"brave":    97,
+		"breathe":  98,
+		"briefing": 99,
+		"broken":   100,
+		"brother":  101,
+		"browser":  102,
+		"bucket":   103,
+		"budget":   104,
+		"building": 105,
+		"bulb":     106,
+		"bulge":    107,
+		"bumpy":    108,
+		"bundle":   109,
+		"burden":   110,
+		"burning":  111,
+		"busy":     112,
+		"buyer":    113,
+		"cage":     114,
+		"calcium":  115,
+		"camera":   116,
+		"campus":   117,
+		"canyon":   118,
+		"capacity": 119,
+		"capital":  120,
+		"capture":  121,
+		"carbon":   122,
+		"cards":    123,
+		"careful":  124,
+		"cargo":    125,
+		"carpet":   126,
+		"carve":    127,
+		"category": 128,
+		"cause":    129,
+		"ceiling":  130,
+		"center":   131,
+		"ceramic":  132,
+		"champion": 133,
+		"change":   134,
+		"charity":  135,
+		"check":    136,
+		"chemical": 137,
+		"chest":    138,
+		"chew":     139,
+		"chubby":   140,
+		"cinema":   141,
+		"civil":    142,
+		"class":    143,
+		"clay":     144,
+		"cleanup":  145,
+		"client":   146,
+		"climate":  147,
+		"clinic":   148,
+		"clock":    149,
+		"clogs":    150,
+		"closet":   151,
+		"clothes":  152,
+		"club":     153,
+		"cluster":  154,
+		"coal":     155,
+		"coastal":  156,
+		"coding":   157,
+		"column":   158,
+		"company":  159,
+		"corner":   160,
+		"costume":  161,
+		"counter":  162,
+		"course":   163,
+		"cover":    164,
+		"cowboy":   165,
+		"cradle":   166,
+		"craft":    167,
+		"crazy":    168,
+		"credit":   169,
+		"cricket":  170,
+		"criminal": 171,
+		"crisis":   172,
+		"critical": 173,
+		"crowd":    174,
+		"crucial":  175,
+		"crunch":   176,
+		"crush":    177,
+		"crystal":  178,
+		"cubic":    179,
+		"cultural": 180,
+		"curious":  181,
+		"curly":    182,
+		"custody":  183,
+		"cylinder": 184,
+		"daisy":    185,
+		"damage":   186,
+		"dance":    187,
+		"darkness": 188,
+		"database": 189,
+		"daughter": 190,
+		"deadline": 191,
+		"deal":     192,
+		"debris":   193,
+		"debut":    194,
+		"decent":   195,
+		"decision": 196,
+		"declare":  197,
+		"decorate": 198,
+		"decrease": 199,
+		"deliver":  200,
+		"demand":   201,
+		"density":  202,
+		"deny":     203,
+		"depart":   204,
+		"depend":   205,
+		"depict":   206,
+		"deploy":   207,
+		"describe": 208,
+		"desert":   209,
+		"desire":   210,
+		"desktop":  211,
+		"destroy":  212,
+		"detailed": 213,
+		"detect":   214,
+		"device":   215,
+		"devote":   216,
+		"diagnose": 217,
+		"dictate":  218,
+		"diet":     219,
+		"dilemma":  220,
+		"diminish": 221,
+		"dining":   222,
+		"diploma":  223,
+		"disaster": 224,
+		"discuss":  225,
+		"disease":  226,
+		"dish":     227,
+		"dismiss":  228,
+		"display":  229,
+		"distance": 230,
+		"dive":     231,
+		"divorce":  232,
+		"document": 233,
+		"domain":   234,
+		"domestic": 235,
+		"dominant": 236,
+		"dough":    237,
+		"downtown": 238,
+		"dragon":   239,
+		"dramatic": 240,
+		"dream":    241,
+		"dress":    242,
+		"drift":    243,
+		"drink":    244,
+		"drove":    245,
+		"drug":     246,
+		"dryer":    247,
+		"duckling": 248,
+		"duke":     249,
+		"duration": 250,
+		"dwarf":    251,
+		"dynamic":  252,
+		"early":    253,
+		"earth":    254,
+		"easel":    255,
+		"easy":     256,
+		"echo":     257,
+		"eclipse":  258,
+		"ecology":  259,
+		"edge":     260,
+		"editor":   261,
+		"educate":  262,
+		"either":   263,
+		"elbow":    264,
+		"elder":    265,
+		"election": 266,
+		"elegant":  267,
+		"element":  268,
+		"elephant": 269,
+		"elevator": 270,
+		"elite":    271,
+		"else":     272,
+		"email":    273,
+		"emerald":  274,
+		"emission": 275,
+		"emperor":  276,
+		"emphasis": 277,
+		"employer": 278,
+		"empty":    279,
+		"ending":   280,
+		"endless":  281,
+		"endorse":  282,
+		"enemy":    283,
+		"energy":   284,
+		"enforce":  285,
+		"engage":   286,
+		"enjoy":    287,
+		"enlarge":  288,
+		"entrance": 289,
+		"envelope": 290,
+		"envy":     291,
+		"epidemic": 292,
+		"episode":  293,
+		"equation": 294,
+		"equip":    295,
+		"eraser":   296,
+		"erode":    297,
+		"escape":   298,
+		"estate":   299,
+		"estimate": 300,
+		"evaluate": 301,
+		"evening":  302,
+		"evidence": 303,
+		"evil":     304,
+		"evoke":    305,
+		"exact":    306,
+		"example":  307,
+		"exceed":   308,
+		"exchange": 309,
+		"exclude":  310,
+		"excuse":   311,
+		"execute":  312,
+		"exercise": 313,
+		"exhaust":  314,
+		"exotic":   315,
+		"expand":   316,
+		"expect":   317,
+		"explain":  318,
+		"express":  319,
+		"extend":   320,
+		"extra":    321,
+		"eyebrow":  322,
+		"facility": 323,
+		"fact":     324,
+		"failure":  325,
+		"faint":    326,
+		"fake":     327,
+		"false":    328,
+		"family":   329,
+		"famous":   330,
+		"fancy":    331,
+		"fangs":    332,
+		"fantasy":  333,
+		"fatal":    334,
+		"fatigue":  335,
+		"favorite": 336,
+		"fawn":     337,
+		"fiber":    338,
+		"fiction":  339,
+		"filter":   340,
+		"finance":  341,
+		"findings": 342,
+		"finger":   343,
+		"firefly":  344,
+		"firm":     345,
+		"fiscal":   346,
+		"fishing":  347,
+		"fitness":  348,
+		"flame":    349,
+		"flash":    350,
+		"flavor":   351,
+		"flea":     352,
+		"flexible": 353,
+		"flip":     354,
+		"float":    355,
+		"floral":   356,
+		"fluff":    357,
+		"focus":    358,
+		"forbid":   359,
+		"force":    360,
+		"forecast": 361,
+		"forget":   362,
+		"formal":   363,
+		"fortune":  364,
+		"forward":  365,
+		"founder":  366,
+		"fraction": 367,
+		"fragment": 368,
+		"frequent": 369,
+		"freshman": 370,
+		"friar":    371,
+		"fridge":   372,
+		"friendly": 373,
+		"frost":    374,
+		"froth":    375,
+		"frozen":   376,
+		"fumes":    377,
+		"funding":  378,
+		"furl":     379,
+		"fused":    380,
+		"galaxy":   381,
+		"game":     382,
+		"garbage":  383,
+		"garden":   384,
+		"garlic":   385,
+		"gasoline": 386,
+		"gather":   387,
+		"general":  388,
+		"genius":   389,
+		"genre":    390,
+		"genuine":  391,
+		"geology":  392,
+		"gesture":  393,
+		"glad":     394,
+		"glance":   395,
+		"glasses":  396,
+		"glen":     397,
+		"glimpse":  398,
+		"goat":     399,
+		"golden":   400,
+		"graduate": 401,
+		"grant":    402,
+		"grasp":    403,
+		"gravity":  404,
+		"gray":     405,
+		"greatest": 406,
+		"grief":    407,
+		"grill":    408,
+		"grin":     409,
+		"grocery":  410,
+		"gross":    411,
+		"group":    412,
+		"grownup":  413,
+		"grumpy":   414,
+		"guard":    415,
+		"guest":    416,
+		"guilt":    417,
+		"guitar":   418,
+		"gums":     419,
+		"hairy":    420,
+		"hamster":  421,
+		"hand":     422,
+		"hanger":   423,
+		"harvest":  424,
+		"have":     425,
+		"havoc":    426,
+		"hawk":     427,
+		"hazard":   428,
+		"headset":  429,
+		"health":   430,
+		"hearing":  431,
+		"heat":     432,
+		"helpful":  433,
+		"herald":   434,
+		"herd":     435,
+		"hesitate": 436,
+		"hobo":     437,
+		"holiday":  438,
+		"holy":     439,
+		"home":     440,
+		"hormone":  441,
+		"hospital": 442,
+		"hour":     443,
+		"huge":     444,
+		"human":    445,
+		"humidity": 446,
+		"hunting":  447,
+		"husband":  448,
+		"hush":     449,
+		"husky":    450,
+		"hybrid":   451,
+		"idea":     452,
+		"identify": 453,
+		"idle":     454,
+		"image":    455,
+		"impact":   456,
+		"imply":    457,
+		"improve":  458,
+		"impulse":  459,
+		"include":  460,
+		"income":   461,
+		"increase": 462,
+		"index":    463,
+		"indicate": 464,
+		"industry": 465,
+		"infant":   466,
+		"inform":   467,
+		"inherit":  468,
+		"injury":   469,
+		"inmate":   470,
+		"insect":   471,
+		"inside":   472,
+		"install":  473,
+		"intend":   474,
+		"intimate": 475,
+		"invasion": 476,
+		"involve":  477,
+		"iris":     478,
+		"island":   479,
+		"isolate":  480,
+		"item":     481,
+		"ivory":    482,
+		"jacket":   483,
+		"jerky":    484,
+		"jewelry":  485,
+		"join":     486,
+		"judicial": 487,
+		"juice":    488,
+		"jump":     489,
+		"junction": 490,
+		"junior":   491,
+		"junk":     492,
+		"jury":     493,
+		"justice":  494,
+		"kernel":   495,
+		"keyboard": 496,
+		"kidney":   497,
+		"kind":     498,
+		"kitchen":  499,
+		"knife":    500,
+		"knit":     501,
+		"laden":    502,
+		"ladle":    503,
+		"ladybug":  504,
+		"lair":     505,
+		"lamp":     506,
+		"language": 507,
+		"large":    508,
+		"laser":    509,
+		"laundry":  510,
+		"lawsuit":  511,
+		"leader":   512,
+		"leaf":     513,
+		"learn":    514,
+		"leaves":   515,
+		"lecture":  516,
+		"legal":    517,
+		"legend":   518,
+		"legs":     519,
+		"lend":     520,
+		"length":   521,
+		"level":    522,
+		"liberty":  523,
+		"library":  524,
+		"license":  525,
+		"lift":     526,
+		"likely":   527,
+		"lilac":    528,
+		"lily":     529,
+		"lips":     530,
+		"liquid":   531,
+		"listen":   532,
+		"literary": 533,
+		"living":   534,
+		"lizard":   535,
+		"loan":     536,
+		"lobe":     537,
+		"location": 538,
+		"losing":   539,
+		"loud":     540,
+		"loyalty":  541,
+		"luck":     542,
+		"lunar":    543,
+		"lunch":    544,
+		"lungs":    545,
+		"luxury":   546,
+		"lying":    547,
+		"lyrics":   548,
+		"machine":  549,
+		"magazine": 550,
+		"maiden":   551,
+		"mailman":  552,
+		"main":     553,
+		"makeup":   554,
+		"making":   555,
+		"mama":     556,
+		"manager":  557,
+		"mandate":  558,
+		"mansion":  559,
+		"manual":   560,
+		"marathon": 561,
+		"march":    562,
+		"market":   563,
+		"marvel":   564,
+		"mason":    565,
+		"material": 566,
+		"math":     567,
+		"maximum":  568,
+		"mayor":    569,
+		"meaning":  570,
+		"medal":    571,
+		"medical":  572,
+		"member":   573,
+		"memory":   574,
+		"mental":   575,
+		"merchant": 576,
+		"merit":    577,
+		"method":   578,
+		"metric":   579,
+		"midst":    580,
+		"mild":     581,
+		"military": 582,
+		"mineral":  583,
+		"minister": 584,
+		"miracle":  585,
+		"mixed":    586,
+		"mixture":  587,
+		"mobile":   588,
+		"modern":   589,
+		"modify":   590,
+		"moisture": 591,
+		"moment":   592,
+		"morning":  593,
+		"mortgage": 594,
+		"mother":   595,
+		"mountain": 596,
+		"mouse":    597,
+		"move":     598,
+		"much":     599,
+		"mule":     600,
+		"multiple": 601,
+		"muscle":   602,
+		"museum":   603,
+		"music":    604,
+		"mustang":  605,
+		"nail":     606,
+		"national": 607,
+		"necklace": 608,
+		"negative": 609,
+		"nervous":  610,
+		"network":  611,
+		"news":     612,
+		"nuclear":  613,
+		"numb":     614,
+		"numerous": 615,
+		"nylon":    616,
+		"oasis":    617,
+		"obesity":  618,
+		"object":   619,
+		"observe":  620,
+		"obtain":   621,
+		"ocean":    622,
+		"often":    623,
+		"olympic":  624,
+		"omit":     625,
+		"oral":     626,
+		"orange":   627,
+		"orbit":    628,
+		"order":    629,
+		"ordinary": 630,
+		"organize": 631,
+		"ounce":    632,
+		"oven":     633,
+		"overall":  634,
+		"owner":    635,
+		"paces":    636,
+		"pacific":  637,
+		"package":  638,
+		"paid":     639,
+		"painting": 640,
+		"pajamas":  641,
+		"pancake":  642,
+		"pants":    643,
+		"papa":     644,
+		"paper":    645,
+		"parcel":   646,
+		"parking":  647,
+		"party":    648,
+		"patent":   649,
+		"patrol":   650,
+		"payment":  651,
+		"payroll":  652,
+		"peaceful": 653,
+		"peanut":   654,
+		"peasant":  655,
+		"pecan":    656,
+		"penalty":  657,
+		"pencil":   658,
+		"percent":  659,
+		"perfect":  660,
+		"permit":   661,
+		"petition": 662,
+		"phantom":  663,
+		"pharmacy": 664,
+		"photo":    665,
+		"phrase":   666,
+		"physics":  667,
+		"pickup":   668,
+		"picture":  669,
+		"piece":    670,
+		"pile":     671,
+		"pink":     672,
+		"pipeline": 673,
+		"pistol":   674,
+		"pitch":    675,
+		"plains":   676,
+		"plan":     677,
+		"plastic":  678,
+		"platform": 679,
+		"playoff":  680,
+		"pleasure": 681,
+		"plot":     682,
+		"plunge":   683,
+		"practice": 684,
+		"prayer":   685,
+		"preach":   686,
+		"predator": 687,
+		"pregnant": 688,
+		"premium":  689,
+		"prepare":  690,
+		"presence": 691,
+		"prevent":  692,
+		"priest":   693,
+		"primary":  694,
+		"priority": 695,
+		"prisoner": 696,
+		"privacy":  697,
+		"prize":    698,
+		"problem":  699,
+		"process":  700,
+		"profile":  701,
+		"program":  702,
+		"promise":  703,
+		"prospect": 704,
+		"provide":  705,
+		"prune":    706,
+		"public":   707,
+		"pulse":    708,
+		"pumps":    709,
+		"punish":   710,
+		"puny":     711,
+		"pupal":    712,
+		"purchase": 713,
+		"purple":   714,
+		"python":   715,
+		"quantity": 716,
+		"quarter":  717,
+		"quick":    718,
+		"quiet":    719,
+		"race":     720,
+		"racism":   721,
+		"radar":    722,
+		"railroad": 723,
+		"rainbow":  724,
+		"raisin":   725,
+		"random":   726,
+		"ranked":   727,
+		"rapids":   728,
+		"raspy":    729,
+		"reaction": 730,
+		"realize":  731,
+		"rebound":  732,
+		"rebuild":  733,
+		"recall":   734,
+		"receiver": 735,
+		"recover":  736,
+		"regret":   737,
+		"regular":  738,
+		"reject":   739,
+		"relate":   740,
+		"remember": 741,
+		"remind":   742,
+		"remove":   743,
+		"render":   744,
+		"repair":   745,
+		"repeat":   746,
+		"replace":  747,
+		"require":  748,
+		"rescue":   749,
+		"research": 750,
+		"resident": 751,
+		"response": 752,
+		"result":   753,
+		"retailer": 754,
+		"retreat":  755,
+		"reunion":  756,
+		"revenue":  757,
+		"review":   758,
+		"reward":   759,
+		"rhyme":    760,
+		"rhythm":   761,
+		"rich":     762,
+		"rival":    763,
+		"river":    764,
+		"robin":    765,
+		"rocky":    766,
+		"romantic": 767,
+		"romp":     768,
+		"roster":   769,
+		"round":    770,
+		"royal":    771,
+		"ruin":     772,
+		"ruler":    773,
+		"rumor":    774,
+		"sack":     775,
+		"safari":   776,
+		"salary":   777,
+		"salon":    778,
+		"salt":     779,
+		"satisfy":  780,
+		"satoshi":  781,
+		"saver":    782,
+		"says":     783,
+		"scandal":  784,
+		"scared":   785,
+		"scatter":  786,
+		"scene":    787,
+		"scholar":  788,
+		"science":  789,
+		"scout":    790,
+		"scramble": 791,
+		"screw":    792,
+		"script":   793,
+		"scroll":   794,
+		"seafood":  795,
+		"season":   796,
+		"secret":   797,
+		"security": 798,
+		"segment":  799,
+		"senior":   800,
+		"shadow":   801,
+		"shaft":    802,
+		"shame":    803,
+		"shaped":   804,
+		"sharp":    805,
+		"shelter":  806,
+		"sheriff":  807,
+		"short":    808,
+		"should":   809,
+		"shrimp":   810,
+		"sidewalk": 811,
+		"silent":   812,
+		"silver":   813,
+		"similar":  814,
+		"simple":   815,
+		"single":   816,
+		"sister":   817,
+		"skin":     818,
+		"skunk":    819,
+		"slap":     820,
+		"slavery":  821,
+		"sled":     822,
+		"slice":    823,
+		"slim":     824,
+		"slow":     825,
+		"slush":    826,
+		"smart":    827,
+		"smear":    828,
+		"smell":    829,
+		"smirk":    830,
+		"smith":    831,
+		"smoking":  832,
+		"smug":     833,
+		"snake":    834,
+		"snapshot": 835,
+		"sniff":    836,
+		"society":  837,
+		"software": 838,
+		"soldier":  839,
+		"solution": 840,
+		"soul":     841,
+		"source":   842,
+		"space":    843,
+		"spark":    844,
+		"speak":    845,
+		"species":  846,
+		"spelling": 847,
+		"spend":    848,
+		"spew":     849,
+		"spider":   850,
+		"spill":    851,
+		"spine":    852,
+		"spirit":   853,
+		"spit":     854,
+		"spray":    855,
+		"sprinkle": 856,
+		"square":   857,
+		"squeeze":  858,
+		"stadium":  859,
+		"staff":    860,
+		"standard": 861,
+		"starting": 862,
+		"station":  863,
+		"stay":     864,
+		"steady":   865,
+		"step":     866,
+		"stick":    867,
+		"stilt":    868,
+		"story":    869,
+		"strategy": 870,
+		"strike":   871,
+		"style":    872,
+		"subject":  873,
+		"submit":   874,
+		"sugar":    875,
+		"suitable": 876,
+		"sunlight": 877,
+		"superior": 878,
+		"surface":  879,
+		"surprise": 880,
+		"survive":  881,
+		"sweater":  882,
+		"swimming": 883,
+		"swing":    884,
+		"switch":   885,
+		"symbolic": 886,
+		"sympathy": 887,
+		"syndrome": 888,
+		"system":   889,
+		"tackle":   890,
+		"tactics":  891,
+		"tadpole":  892,
+		"talent":   893,
+		"task":     894,
+		"taste":    895,
+		"taught":   896,
+		"taxi":     897,
+		"teacher":  898,
+		"teammate": 899,
+		"teaspoon": 900,
+		"temple":   901,
+		"tenant":   902,
+		"tendency": 903,
+		"tension":  904,
+		"terminal": 905,
+		"testify":  906,
+		"texture":  907,
+		"thank":    908,
+		"that":     909,
+		"theater":  910,
+		"theory":   911,
+		"therapy":  912,
+		"thorn":    913,
+		"threaten": 914,
+		"thumb":    915,
+		"thunder":  916,
+		"ticket":   917,
+		"tidy":     918,
+		"timber":   919,
+		"timely":   920,
+		"ting":     921,
+		"tofu":     922,
+		"together": 923,
+		"tolerate": 924,
+		"total":    925,
+		"toxic":    926,
+		"tracks":   927,
+		"traffic":  928,
+		"training": 929,
+		"transfer": 930,
+		"trash":    931,
+		"traveler": 932,
+		"treat":    933,
+		"trend":    934,
+		"trial":    935,
+		"tricycle": 936,
+		"trip":     937,
+		"triumph":  938,
+		"trouble":  939,
+		"true":     940,
+		"trust":    941,
+		"twice":    942,
+		"twin":     943,
+		"type":     944,
+		"typical":  945,
+		"ugly":     946,
+		"ultimate": 947,
+		"umbrella": 948,
+		"uncover":  949,
+		"undergo":  950,
+		"unfair":   951,
+		"unfold":   952,
+		"unhappy":  953,
+		"union":    954,
+		"universe": 955,
+		"unkind":   956,
+		"unknown":  957,
+		"unusual":  958,
+		"unwrap":   959,
+		"upgrade":  960,
+		"upstairs": 961,
+		"username": 962,
+		"usher":    963,
+		"usual":    964,
+		"valid":    965,
+		"valuable": 966,
+		"vampire":  967,
+		"vanish":   968,
+		"various":  969,
+		"vegan":    970,
+		"velvet":   971,
+		"venture":  972,
+		"verdict":  973,
+		"verify":   974,
+		"very":     975,
+		"veteran":  976,
+		"vexed":    977,
+		"victim":   978,
+		"video":    979,
+		"view":     980,
+		"vintage":  981,
+		"violence": 982,
+		"viral":    983,
+		"visitor":  984,
+		"visual":   985,
+		"vitamins": 986,
+		"vocal":    987,
+		"voice":    988,
+		"volume":   989,
+		"voter":    990,
+		"voting":   991,
+		"walnut":   992,
+		"warmth":   993,
+		"warn":     994,
+		"watch":    995,
+		"wavy":     996,
+		"wealthy":  997,
+		"weapon":   998,
+		"webcam":   999,
+		"welcome":  1000,
+		"welfare":  1001,
+		"western":  1002,
+		"width":    1003,
+		"wildlife": 1004,
+		"window":   1005,
+		"wine":     1006,
+		"wireless": 1007,
+		"wisdom":   1008,
+		"withdraw": 1009,
+		"wits":     1010,
+		"wolf":     1011,
+		"woman":    1012,
+		"work":     1013,
+		"worthy":   1014,
+		"wrap":     1015,
+		"wrist":    1016,
+		"writing":  1017,
+		"wrote":    1018,
+		"year":     1019,
+		"yelp":     1020,
+		"yield":    1021,
+		"yoga":     1022,
+		"zero":     1023,
+	}
+)